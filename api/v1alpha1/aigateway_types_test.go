@@ -0,0 +1,119 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStoresConfigInSecret(t *testing.T) {
+	tests := []struct {
+		storage  ConfigStorage
+		expected bool
+	}{
+		{"", true},
+		{ConfigStorageSecret, true},
+		{ConfigStorageConfigMap, false},
+	}
+	for _, tt := range tests {
+		spec := &AiGatewaySpec{ConfigStorage: tt.storage}
+		if got := spec.StoresConfigInSecret(); got != tt.expected {
+			t.Errorf("StoresConfigInSecret() with configStorage %q = %v, expected %v", tt.storage, got, tt.expected)
+		}
+	}
+}
+
+func TestQualifiedName(t *testing.T) {
+	tests := []struct {
+		model    AiModel
+		expected string
+	}{
+		{AiModel{Name: "gpt-4o", Provider: "openai"}, "openai/gpt-4o"},
+		{AiModel{Name: "openai/gpt-4o", Provider: "openai"}, "openai/gpt-4o"},
+		{AiModel{Name: "meta-llama/Llama-3-8B", Provider: "huggingface"}, "huggingface/meta-llama/Llama-3-8B"},
+		{AiModel{Name: "*", Provider: "anthropic"}, "anthropic/*"},
+	}
+	for _, tt := range tests {
+		if got := tt.model.QualifiedName(); got != tt.expected {
+			t.Errorf("QualifiedName() of %s/%s = %q, expected %q", tt.model.Provider, tt.model.Name, got, tt.expected)
+		}
+		parsed, ok := ParseQualifiedName(tt.model.QualifiedName())
+		if !ok || parsed.QualifiedName() != tt.expected {
+			t.Errorf("ParseQualifiedName(%q) = %+v, %v", tt.expected, parsed, ok)
+		}
+	}
+
+	for _, s := range []string{"gpt-4o", "/gpt-4o", "openai/"} {
+		if _, ok := ParseQualifiedName(s); ok {
+			t.Errorf("ParseQualifiedName(%q) succeeded, expected it to fail", s)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		model    AiModel
+		expected AiModel
+	}{
+		{AiModel{Name: "gpt-4o", Provider: "openai"}, AiModel{Name: "openai/gpt-4o", Provider: "openai", Alias: "gpt-4o"}},
+		{AiModel{Name: " gpt-4o\t", Provider: " OpenAI", Alias: "gpt "}, AiModel{Name: "openai/gpt-4o", Provider: "openai", Alias: "gpt"}},
+		{AiModel{Name: "openai/gpt-4o", Provider: "openai"}, AiModel{Name: "openai/gpt-4o", Provider: "openai"}},
+		{AiModel{Name: "OpenAI/gpt-4o", Provider: "OPENAI", Alias: "gpt"}, AiModel{Name: "openai/gpt-4o", Provider: "openai", Alias: "gpt"}},
+		{AiModel{Name: "OpenAI/*", Provider: "openai"}, AiModel{Name: "openai/*", Provider: "openai"}},
+		{AiModel{Name: "*", Provider: "openai"}, AiModel{Name: "*", Provider: "openai"}},
+		{AiModel{Name: "openai/", Provider: "openai"}, AiModel{Name: "openai/", Provider: "openai"}},
+		{AiModel{Name: "meta-llama/Llama-3-8B", Provider: "huggingface"}, AiModel{Name: "huggingface/meta-llama/Llama-3-8B", Provider: "huggingface", Alias: "meta-llama/Llama-3-8B"}},
+	}
+	for _, tt := range tests {
+		got := tt.model
+		got.Normalize()
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Normalize() of %+v = %+v, expected %+v", tt.model, got, tt.expected)
+		}
+	}
+}
+
+func TestNormalizeEquivalentModels(t *testing.T) {
+	equivalent := [][]AiModel{
+		{
+			{Name: "openai/gpt-4o", Provider: "openai"},
+			{Name: "OpenAI/gpt-4o", Provider: "OpenAI"},
+			{Name: " OPENAI/gpt-4o", Provider: "openai "},
+		},
+		{
+			{Name: "gpt-4o", Provider: "openai"},
+			{Name: "gpt-4o", Provider: "OpenAI", Alias: "gpt-4o"},
+			{Name: "openai/gpt-4o", Provider: "openai", Alias: "gpt-4o"},
+		},
+		{
+			{Name: "gpt-4o", Provider: "openai", Alias: "gpt"},
+			{Name: "OpenAI/gpt-4o", Provider: "OpenAI", Alias: "gpt"},
+		},
+	}
+	for _, models := range equivalent {
+		first := models[0]
+		first.Normalize()
+		for _, model := range models[1:] {
+			got := model
+			got.Normalize()
+			if !reflect.DeepEqual(got, first) {
+				t.Errorf("Normalize() of %+v = %+v, expected %+v", model, got, first)
+			}
+		}
+	}
+}
@@ -27,11 +27,93 @@ type AiGatewayClassSpec struct {
 	// Controller is the name of the controller that should handle this gateway class
 	// +kubebuilder:validation:Required
 	Controller string `json:"controller"`
+
+	// ParametersRef references a resource holding implementation-specific configuration of the class,
+	// such as the gateway image, logging or telemetry settings. Its kind is defined by the controller,
+	// e.g. a ConfigMap or a custom resource of the implementation.
+	// +optional
+	ParametersRef *ParametersReference `json:"parametersRef,omitempty"`
+
+	// AllowedProviders restricts the model providers of the gateways of this class, e.g. to the providers the
+	// implementation supports or the organization approved. If set, AiGateways and ClusterAiGateways with models
+	// of other providers are rejected at admission instead of failing at runtime.
+	// +optional
+	AllowedProviders []string `json:"allowedProviders,omitempty"`
+
+	// MaxModelsPerGateway limits the number of models of each gateway of this class, including the models it
+	// imports from AiModelLibraries and inherits from its baselines. Large model lists inflate the generated
+	// configuration and slow down the start of the gateway. If set, gateways with more models are rejected at
+	// admission.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxModelsPerGateway *int32 `json:"maxModelsPerGateway,omitempty"`
+
+	// TemplatesRef references a ConfigMap of Go templates for the Deployment, Service and ConfigMap
+	// implementations create for the gateways of this class. They are rendered with the AiGateway as input and
+	// replace the resources of the implementation, to customize gateways beyond the typed fields without forking
+	// the implementation. See RenderTemplates for the keys and the input of the templates.
+	// +optional
+	TemplatesRef *TemplatesReference `json:"templatesRef,omitempty"`
 }
 
+// TemplatesReference identifies the ConfigMap holding the resource templates of an AiGatewayClass.
+type TemplatesReference struct {
+	// Name is the name of the ConfigMap.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the ConfigMap. Defaults to the namespace of the AiGatewayClass.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// ParametersReference identifies a resource holding the parameters of an AiGatewayClass.
+type ParametersReference struct {
+	// Group is the API group of the referenced resource. Empty for the core API group, e.g. for ConfigMaps.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Kind is the kind of the referenced resource.
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Name is the name of the referenced resource.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the referenced resource. Defaults to the namespace of the AiGatewayClass.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Condition types and reasons of AiGatewayClass.
+const (
+	// AiGatewayClassConditionAccepted is True if spec.controller names the controller of an operator running in
+	// the cluster, i.e. the gateways of the class are handled. See AiGatewayClass.AcceptedCondition.
+	AiGatewayClassConditionAccepted = "Accepted"
+	// AiGatewayClassReasonAccepted is the reason of the Accepted condition of a handled class.
+	AiGatewayClassReasonAccepted = "Accepted"
+	// AiGatewayClassReasonUnsupported is the reason of the Accepted condition of a class no operator handles.
+	AiGatewayClassReasonUnsupported = "Unsupported"
+	// AiGatewayClassConditionDefault is set on classes marked with DefaultClassAnnotation if several classes may
+	// be marked: True for the class AiGateways without aiGatewayClassName default to, see ResolveDefaultClass.
+	AiGatewayClassConditionDefault = "Default"
+	// AiGatewayClassReasonDefault is the reason of the Default condition of the resolved default class.
+	AiGatewayClassReasonDefault = "Default"
+	// AiGatewayClassReasonSuperseded is the reason of the Default condition of a class marked as default while an
+	// older class is marked as well.
+	AiGatewayClassReasonSuperseded = "Superseded"
+)
+
 // AiGatewayClassStatus defines the observed state of AiGatewayClass.
 type AiGatewayClassStatus struct {
+	// Conditions describe the current state of the class, e.g. whether it is Accepted.
+	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the generation of the class the status was last updated for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // +kubebuilder:object:root=true
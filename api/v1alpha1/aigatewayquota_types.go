@@ -0,0 +1,87 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AiGatewayQuotaSpec defines the limits for AiGateways in the namespace of the quota.
+// Omitted limits are not enforced.
+type AiGatewayQuotaSpec struct {
+	// MaxGateways is the maximum number of AiGateways in the namespace.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxGateways *int32 `json:"maxGateways,omitempty"`
+
+	// MaxModels is the maximum number of models across all AiGateways in the namespace.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxModels *int32 `json:"maxModels,omitempty"`
+
+	// MaxReplicas is the maximum number of gateway replicas across all AiGateways in the namespace.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+}
+
+// AiGatewayQuotaUsage reports the resources counted against an AiGatewayQuota.
+type AiGatewayQuotaUsage struct {
+	// Gateways is the number of AiGateways in the namespace.
+	Gateways int32 `json:"gateways"`
+
+	// Models is the number of models across all AiGateways in the namespace.
+	Models int32 `json:"models"`
+
+	// Replicas is the number of gateway replicas across all AiGateways in the namespace.
+	Replicas int32 `json:"replicas"`
+}
+
+// AiGatewayQuotaStatus defines the observed state of AiGatewayQuota.
+type AiGatewayQuotaStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Used reports the current usage in the namespace.
+	// +optional
+	Used *AiGatewayQuotaUsage `json:"used,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiGatewayQuota is the Schema for the aigatewayquotas API.
+// It limits the number of AiGateways, models and replicas in its namespace.
+type AiGatewayQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiGatewayQuotaSpec   `json:"spec,omitempty"`
+	Status AiGatewayQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AiGatewayQuotaList contains a list of AiGatewayQuota.
+type AiGatewayQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiGatewayQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiGatewayQuota{}, &AiGatewayQuotaList{})
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AiGatewayRouteSpec defines how requests to paths of an AiGateway are routed to its models.
+type AiGatewayRouteSpec struct {
+	// ParentRef references the AiGateway in the namespace of the route the route is attached to.
+	ParentRef corev1.LocalObjectReference `json:"parentRef"`
+
+	// Rules are the routing rules of the route. A request is handled by the rule with the longest
+	// matching path.
+	// +kubebuilder:validation:MinItems=1
+	Rules []AiGatewayRouteRule `json:"rules"`
+}
+
+// AiGatewayRouteRule routes requests matching one of its paths to its backend models.
+type AiGatewayRouteRule struct {
+	// Matches are the paths handled by the rule. A request matches if it matches any of them.
+	// +kubebuilder:validation:MinItems=1
+	Matches []PathMatch `json:"matches"`
+
+	// BackendRefs are the models of the parent AiGateway requests are sent to, proportionally to their weights.
+	// +kubebuilder:validation:MinItems=1
+	BackendRefs []ModelBackendRef `json:"backendRefs"`
+
+	// Mirror sends a copy of the requests matching the rule to a shadow model of the parent AiGateway.
+	// +optional
+	Mirror *MirrorConfig `json:"mirror,omitempty"`
+
+	// PromptPolicy overrides the prompt policies of the parent AiGateway and its models for requests matching
+	// the rule, e.g. to enforce a system prompt for an application.
+	// +optional
+	PromptPolicy *PromptPolicy `json:"promptPolicy,omitempty"`
+}
+
+// PathMatchType is how the path of a request is matched.
+// +kubebuilder:validation:Enum=Exact;PathPrefix
+type PathMatchType string
+
+const (
+	// PathMatchExact matches request paths equal to the value.
+	PathMatchExact PathMatchType = "Exact"
+	// PathMatchPathPrefix matches request paths starting with the value, element by element.
+	PathMatchPathPrefix PathMatchType = "PathPrefix"
+)
+
+// PathMatch matches the path of a request.
+type PathMatch struct {
+	// Type is how the path is matched.
+	// +kubebuilder:default=PathPrefix
+	// +optional
+	Type PathMatchType `json:"type,omitempty"`
+
+	// Value is the path to match.
+	// +kubebuilder:validation:Pattern=`^/`
+	Value string `json:"value"`
+}
+
+// ModelBackendRef references a model of the parent AiGateway.
+type ModelBackendRef struct {
+	// Model is the public name (alias or name) of the model.
+	// +kubebuilder:validation:MinLength=1
+	Model string `json:"model"`
+
+	// Weight is the proportion of requests sent to the model, relative to the other backends of the rule.
+	// A weight of 0 sends no requests to the model.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000000
+	// +kubebuilder:default=1
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// Condition types reported in AiGatewayRouteStatus.Conditions.
+const (
+	// AiGatewayRouteConditionAccepted is True if the route is attached to its parent AiGateway.
+	AiGatewayRouteConditionAccepted = "Accepted"
+	// AiGatewayRouteConditionResolvedRefs is True if all backend models exist in the parent AiGateway.
+	AiGatewayRouteConditionResolvedRefs = "ResolvedRefs"
+)
+
+// AiGatewayRouteStatus defines the observed state of AiGatewayRoute.
+type AiGatewayRouteStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiGatewayRoute is the Schema for the aigatewayroutes API.
+// It manages the path-based routing of an AiGateway separately from its model catalog,
+// similar to an HTTPRoute of the Kubernetes Gateway API.
+type AiGatewayRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiGatewayRouteSpec   `json:"spec,omitempty"`
+	Status AiGatewayRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AiGatewayRouteList contains a list of AiGatewayRoute.
+type AiGatewayRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiGatewayRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiGatewayRoute{}, &AiGatewayRouteList{})
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AiModelLibrarySpec defines reusable model entries.
+type AiModelLibrarySpec struct {
+	// Models are the model entries of the library. Secrets referenced by the models, e.g. provider
+	// credentials, are resolved in the namespace of the importing AiGateway, so every team provides
+	// its own credentials.
+	// +kubebuilder:validation:MinItems=1
+	Models []AiModel `json:"models"`
+}
+
+// AiModelLibraryStatus defines the observed state of AiModelLibrary.
+type AiModelLibraryStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+
+// AiModelLibrary is the Schema for the aimodellibraries API.
+// It defines models that AiGateways in all namespaces import by name via spec.modelLibraries,
+// instead of copying the same model list into every gateway.
+type AiModelLibrary struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiModelLibrarySpec   `json:"spec,omitempty"`
+	Status AiModelLibraryStatus `json:"status,omitempty"`
+}
+
+// Select returns the models of the library whose public names are listed in names,
+// or all models if names is empty.
+func (l *AiModelLibrary) Select(names []string) []AiModel {
+	if len(names) == 0 {
+		return l.Spec.Models
+	}
+	var models []AiModel
+	for _, model := range l.Spec.Models {
+		if slices.Contains(names, model.PublicName()) {
+			models = append(models, model)
+		}
+	}
+	return models
+}
+
+// +kubebuilder:object:root=true
+
+// AiModelLibraryList contains a list of AiModelLibrary.
+type AiModelLibraryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiModelLibrary `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiModelLibrary{}, &AiModelLibraryList{})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AiModelPolicySpec defines which models AiGateways in the namespace of the policy may use.
+type AiModelPolicySpec struct {
+	// AllowedModels lists the models AiGateways may use, as "<provider>/<model>" patterns.
+	// "*" matches any sequence of characters, e.g. "azure/*" allows all Azure models and
+	// "openai/gpt-4o*" allows all GPT-4o variants. A wildcard model of an AiGateway is only
+	// allowed if a pattern covers every model it can match.
+	// If several policies exist in a namespace, a model must be allowed by all of them.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:items:Pattern=`^[^/\s]+/\S+$`
+	AllowedModels []string `json:"allowedModels"`
+}
+
+// AiModelPolicyStatus defines the observed state of AiModelPolicy.
+type AiModelPolicyStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ViolatingGateways lists the AiGateways in the namespace that use models not allowed by the policy,
+	// e.g. because they were created before the policy.
+	// +optional
+	ViolatingGateways []string `json:"violatingGateways,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiModelPolicy is the Schema for the aimodelpolicies API.
+// It restricts the providers and models AiGateways in its namespace may use.
+type AiModelPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiModelPolicySpec   `json:"spec,omitempty"`
+	Status AiModelPolicyStatus `json:"status,omitempty"`
+}
+
+// Allows reports whether one of the allowed model patterns of the policy matches model.
+// A wildcard model name is matched literally, so "openai/*" allows "gpt-*", while "openai/gpt-4*" does not.
+func (p *AiModelPolicy) Allows(model AiModel) bool {
+	return slices.ContainsFunc(p.Spec.AllowedModels, func(pattern string) bool {
+		return globMatch(pattern, model.QualifiedName())
+	})
+}
+
+// globMatch reports whether s matches pattern, in which "*" matches any sequence of characters.
+func globMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		idx := strings.Index(s, part)
+		if idx < 0 {
+			return false
+		}
+		s = s[idx+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+// +kubebuilder:object:root=true
+
+// AiModelPolicyList contains a list of AiModelPolicy.
+type AiModelPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiModelPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiModelPolicy{}, &AiModelPolicyList{})
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestAiModelPolicyAllows(t *testing.T) {
+	policy := &AiModelPolicy{Spec: AiModelPolicySpec{AllowedModels: []string{"azure/*", "openai/gpt-4o*", "*/llama-3"}}}
+	tests := []struct {
+		model    AiModel
+		expected bool
+	}{
+		{AiModel{Provider: "azure", Name: "gpt-4"}, true},
+		{AiModel{Provider: "azure", Name: "*"}, true},
+		{AiModel{Provider: "openai", Name: "gpt-4o"}, true},
+		{AiModel{Provider: "openai", Name: "gpt-4o-mini"}, true},
+		{AiModel{Provider: "openai", Name: "gpt-4"}, false},
+		{AiModel{Provider: "openai", Name: "gpt-*"}, false},
+		{AiModel{Provider: "ollama", Name: "llama-3"}, true},
+		{AiModel{Provider: "ollama", Name: "llama-3.1"}, false},
+	}
+	for _, test := range tests {
+		if got := policy.Allows(test.model); got != test.expected {
+			t.Errorf("Allows(%s/%s) = %v, expected %v", test.model.Provider, test.model.Name, got, test.expected)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// promptVariablePattern matches the placeholders of a prompt template, e.g. "{{ language }}".
+var promptVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// AiPromptTemplateSpec defines the desired state of AiPromptTemplate.
+type AiPromptTemplateSpec struct {
+	// GatewayRef references the AiGateway in the namespace of the template that serves it.
+	// If not set, all AiGateways in the namespace serve the template.
+	// +optional
+	GatewayRef *corev1.LocalObjectReference `json:"gatewayRef,omitempty"`
+
+	// Template is the prompt text. Variables are referenced as "{{ name }}" and must be declared in Variables.
+	// +kubebuilder:validation:MinLength=1
+	Template string `json:"template"`
+
+	// Variables declares the variables of the template.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Variables []PromptVariable `json:"variables,omitempty"`
+}
+
+// PromptVariable is a variable of a prompt template.
+type PromptVariable struct {
+	// Name is the name of the variable in the template.
+	// +kubebuilder:validation:Pattern=`^[A-Za-z_][A-Za-z0-9_]*$`
+	Name string `json:"name"`
+
+	// Description documents the variable for the users of the template.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Default is used if a request does not set the variable. Variables without a default are required.
+	// +optional
+	Default *string `json:"default,omitempty"`
+}
+
+// AiPromptTemplateStatus defines the observed state of AiPromptTemplate.
+type AiPromptTemplateStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiPromptTemplate is the Schema for the aiprompttemplates API.
+// Implementation operators mount its templates into the gateways, which serve them to clients by the name of
+// the AiPromptTemplate, so prompts are managed centrally instead of in every application.
+type AiPromptTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiPromptTemplateSpec   `json:"spec,omitempty"`
+	Status AiPromptTemplateStatus `json:"status,omitempty"`
+}
+
+// Placeholders returns the names of the variables referenced by the template, in order of first use.
+func (t *AiPromptTemplate) Placeholders() []string {
+	var names []string
+	for _, match := range promptVariablePattern.FindAllStringSubmatch(t.Spec.Template, -1) {
+		if !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// Render substitutes the variables of the template with values, falling back to their defaults.
+// It fails if a value is given for an undeclared variable or a variable without default has no value.
+func (t *AiPromptTemplate) Render(values map[string]string) (string, error) {
+	resolved := make(map[string]string, len(t.Spec.Variables))
+	for _, variable := range t.Spec.Variables {
+		if value, ok := values[variable.Name]; ok {
+			resolved[variable.Name] = value
+		} else if variable.Default != nil {
+			resolved[variable.Name] = *variable.Default
+		}
+	}
+	for name := range values {
+		if _, ok := resolved[name]; !ok {
+			return "", fmt.Errorf("prompt template %q has no variable %q", t.Name, name)
+		}
+	}
+
+	var missing []string
+	rendered := promptVariablePattern.ReplaceAllStringFunc(t.Spec.Template, func(placeholder string) string {
+		name := promptVariablePattern.FindStringSubmatch(placeholder)[1]
+		value, ok := resolved[name]
+		if !ok && !slices.Contains(missing, name) {
+			missing = append(missing, name)
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("prompt template %q requires the variables %s", t.Name, strings.Join(missing, ", "))
+	}
+	return rendered, nil
+}
+
+// +kubebuilder:object:root=true
+
+// AiPromptTemplateList contains a list of AiPromptTemplate.
+type AiPromptTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiPromptTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiPromptTemplate{}, &AiPromptTemplateList{})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAiPromptTemplateRender(t *testing.T) {
+	english := "English"
+	tmpl := &AiPromptTemplate{Spec: AiPromptTemplateSpec{
+		Template: "You support {{ company }} in {{language}}. {{ company }} customers come first.",
+		Variables: []PromptVariable{
+			{Name: "company"},
+			{Name: "language", Default: &english},
+		},
+	}}
+	tmpl.Name = "support"
+
+	if got := tmpl.Placeholders(); !slices.Equal(got, []string{"company", "language"}) {
+		t.Errorf("Placeholders() = %v, expected [company language]", got)
+	}
+
+	tests := []struct {
+		name     string
+		values   map[string]string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "default value",
+			values:   map[string]string{"company": "ACME"},
+			expected: "You support ACME in English. ACME customers come first.",
+		},
+		{
+			name:     "overridden default",
+			values:   map[string]string{"company": "ACME", "language": "German"},
+			expected: "You support ACME in German. ACME customers come first.",
+		},
+		{name: "missing required variable", values: nil, wantErr: true},
+		{name: "undeclared variable", values: map[string]string{"company": "ACME", "tone": "formal"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tmpl.Render(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("Render() = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AiRateLimitPolicySpec defines the rate limits of a group of gateway consumers.
+type AiRateLimitPolicySpec struct {
+	// GatewayRef references the AiGateway in the namespace of the policy the limits apply to.
+	// If not set, the limits apply to all AiGateways in the namespace.
+	// +optional
+	GatewayRef *corev1.LocalObjectReference `json:"gatewayRef,omitempty"`
+
+	// Subject selects the consumers that are limited. Exactly one of its fields must be set.
+	Subject RateLimitSubject `json:"subject"`
+
+	// Limits are applied to each selected consumer individually. At least one limit must be set unless
+	// a budget or request limits are set.
+	// +optional
+	Limits RateLimits `json:"limits,omitempty"`
+
+	// Budget limits the spend of each selected consumer per time window. Implementations block the virtual keys
+	// of a consumer that exhausted its budget until the window resets.
+	// +optional
+	Budget *SpendBudget `json:"budget,omitempty"`
+
+	// RequestLimits caps the tokens of every single request of the selected consumers, below the caps of the
+	// gateway and its models.
+	// +optional
+	RequestLimits *RequestTokenLimits `json:"requestLimits,omitempty"`
+}
+
+// RateLimitSubject selects the consumers of a gateway a rate limit applies to.
+type RateLimitSubject struct {
+	// VirtualKey is the alias of a virtual key of the gateway.
+	// +optional
+	VirtualKey string `json:"virtualKey,omitempty"`
+
+	// Team is the ID of a team of the gateway, e.g. status.teamID of an AiTeam.
+	// +optional
+	Team string `json:"team,omitempty"`
+
+	// NamespaceSelector selects the namespaces whose workloads are limited, each namespace individually.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// RateLimits holds the limits of a consumer. Omitted limits are not enforced.
+type RateLimits struct {
+	// RequestsPerMinute is the maximum number of requests per minute.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequestsPerMinute *int32 `json:"requestsPerMinute,omitempty"`
+
+	// TokensPerMinute is the maximum number of prompt and completion tokens per minute.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	TokensPerMinute *int64 `json:"tokensPerMinute,omitempty"`
+
+	// MaxParallelRequests is the maximum number of concurrent requests.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxParallelRequests *int32 `json:"maxParallelRequests,omitempty"`
+}
+
+// SpendBudget is the maximum spend of a consumer within a recurring time window.
+type SpendBudget struct {
+	// MaxSpendUSD is the maximum spend in US dollars as a decimal number, e.g. "100" or "12.50".
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	MaxSpendUSD string `json:"maxSpendUSD"`
+
+	// Window is the duration after which the spend resets, e.g. "24h" or "720h".
+	// Windows start at the creation of the policy.
+	Window metav1.Duration `json:"window"`
+}
+
+// AiRateLimitPolicyStatus defines the observed state of AiRateLimitPolicy.
+type AiRateLimitPolicyStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// BlockedKeys lists the virtual keys blocked because their consumer exhausted spec.budget.
+	// +optional
+	BlockedKeys []BlockedKeyStatus `json:"blockedKeys,omitempty"`
+}
+
+// BlockedKeyStatus reports a virtual key blocked by a budget.
+type BlockedKeyStatus struct {
+	// VirtualKey is the alias of the blocked virtual key.
+	VirtualKey string `json:"virtualKey"`
+
+	// BlockedAt is when the key was blocked.
+	BlockedAt metav1.Time `json:"blockedAt"`
+
+	// UnblockAt is when the budget window resets and the key is unblocked again.
+	UnblockAt metav1.Time `json:"unblockAt"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiRateLimitPolicy is the Schema for the airatelimitpolicies API.
+// It throttles virtual keys, teams or namespaces on the AiGateways in its namespace,
+// without changes to the AiGateway spec.
+type AiRateLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiRateLimitPolicySpec   `json:"spec,omitempty"`
+	Status AiRateLimitPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AiRateLimitPolicyList contains a list of AiRateLimitPolicy.
+type AiRateLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiRateLimitPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiRateLimitPolicy{}, &AiRateLimitPolicyList{})
+}
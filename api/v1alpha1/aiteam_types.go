@@ -0,0 +1,121 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TeamRole is the role of a member within a team.
+// +kubebuilder:validation:Enum=admin;user
+type TeamRole string
+
+const (
+	// TeamRoleAdmin may manage the virtual keys and members of the team.
+	TeamRoleAdmin TeamRole = "admin"
+	// TeamRoleUser may use the models of the team.
+	TeamRoleUser TeamRole = "user"
+)
+
+// AiTeamSpec defines the desired state of AiTeam.
+type AiTeamSpec struct {
+	// GatewayRef references the AiGateway in the namespace of the team the team is created on.
+	// If not set, the team is created on all AiGateways in the namespace.
+	// +optional
+	GatewayRef *corev1.LocalObjectReference `json:"gatewayRef,omitempty"`
+
+	// Alias is the display name of the team in the gateway. Defaults to the name of the AiTeam.
+	// +optional
+	Alias string `json:"alias,omitempty"`
+
+	// Members are the users of the team.
+	// +listType=map
+	// +listMapKey=userID
+	// +optional
+	Members []TeamMember `json:"members,omitempty"`
+
+	// Models lists the public names (alias or name) of the models the team may use.
+	// All models of the gateway are allowed if empty.
+	// +listType=set
+	// +optional
+	Models []string `json:"models,omitempty"`
+
+	// Budget limits the spend of the team per time window.
+	// +optional
+	Budget *SpendBudget `json:"budget,omitempty"`
+}
+
+// TeamMember is a user of a team.
+type TeamMember struct {
+	// UserID is the ID of the user in the gateway, usually the subject of its SSO identity.
+	// +kubebuilder:validation:MinLength=1
+	UserID string `json:"userID"`
+
+	// Email is the email address of the user.
+	// +optional
+	Email string `json:"email,omitempty"`
+
+	// Role is the role of the user within the team.
+	// +kubebuilder:default=user
+	// +optional
+	Role TeamRole `json:"role,omitempty"`
+}
+
+// AiTeamConditionSynced is True once the team has been created or updated in all targeted gateways
+// via their admin API.
+const AiTeamConditionSynced = "Synced"
+
+// AiTeamStatus defines the observed state of AiTeam.
+type AiTeamStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// TeamID is the ID of the team in the gateway. Virtual keys and AiRateLimitPolicies refer to the team by it.
+	// +optional
+	TeamID string `json:"teamID,omitempty"`
+
+	// ObservedGeneration is the generation of the AiTeam last synced to the gateways.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiTeam is the Schema for the aiteams API.
+// Implementation operators sync it to a team of the targeted gateways via their admin API,
+// so teams are managed declaratively instead of in the gateway UI.
+type AiTeam struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiTeamSpec   `json:"spec,omitempty"`
+	Status AiTeamStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AiTeamList contains a list of AiTeam.
+type AiTeamList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiTeam `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiTeam{}, &AiTeamList{})
+}
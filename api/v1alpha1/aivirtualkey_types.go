@@ -0,0 +1,136 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Keys of the Secret an AiVirtualKey is projected into. They are named like the environment variables of the
+// OpenAI SDKs, so workloads can load the Secret with envFrom.
+const (
+	// VirtualKeySecretKeyAPIKey holds the virtual key.
+	VirtualKeySecretKeyAPIKey = "OPENAI_API_KEY"
+	// VirtualKeySecretKeyBaseURL holds the cluster-local URL of the gateway, see AiGatewayStatus.URL.
+	VirtualKeySecretKeyBaseURL = "OPENAI_BASE_URL"
+)
+
+// DefaultKeyRotationInterval is the rotation interval of virtual keys that don't set spec.rotationInterval.
+const DefaultKeyRotationInterval = 30 * 24 * time.Hour
+
+// AiVirtualKeySpec defines the desired state of AiVirtualKey.
+type AiVirtualKeySpec struct {
+	// GatewayRef references the AiGateway in the namespace of the key that issues the key.
+	GatewayRef corev1.LocalObjectReference `json:"gatewayRef"`
+
+	// ServiceAccountName is the ServiceAccount in the namespace of the key whose pods use the key.
+	// The key is tagged with it in the spend logs of the gateway.
+	// +kubebuilder:validation:MinLength=1
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// Models lists the public names (alias or name) of the models the key may use.
+	// All models of the gateway are allowed if empty.
+	// +listType=set
+	// +optional
+	Models []string `json:"models,omitempty"`
+
+	// TeamRef references the AiTeam in the namespace of the key the key belongs to, so it shares the budget of
+	// the team.
+	// +optional
+	TeamRef *corev1.LocalObjectReference `json:"teamRef,omitempty"`
+
+	// SecretName is the name of the Secret the key is projected into. Defaults to "<name>-virtual-key".
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// RotationInterval is how often the key is replaced by a new one. Defaults to 720h (30 days).
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+
+	// GracePeriod is how long the previous key stays valid after a rotation, so that pods pick up the
+	// updated Secret before it is revoked.
+	// +kubebuilder:default="1h"
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+}
+
+// AiVirtualKeyConditionIssued is True once the key has been issued by the gateway and written to the Secret.
+const AiVirtualKeyConditionIssued = "Issued"
+
+// AiVirtualKeyStatus defines the observed state of AiVirtualKey.
+type AiVirtualKeyStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// KeyAlias is the alias of the current key in the gateway, e.g. for AiRateLimitPolicy subjects.
+	// +optional
+	KeyAlias string `json:"keyAlias,omitempty"`
+
+	// LastRotationTime is when the current key was issued.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// AiVirtualKey is the Schema for the aivirtualkeys API.
+// It binds a ServiceAccount to a virtual key of a gateway: implementation operators issue the key via the
+// admin API of the gateway, write it to a Secret the pods of the ServiceAccount load, and rotate it.
+type AiVirtualKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AiVirtualKeySpec   `json:"spec,omitempty"`
+	Status AiVirtualKeyStatus `json:"status,omitempty"`
+}
+
+// SecretName returns the name of the Secret the key is projected into.
+func (k *AiVirtualKey) SecretName() string {
+	if k.Spec.SecretName != "" {
+		return k.Spec.SecretName
+	}
+	return k.Name + "-virtual-key"
+}
+
+// NextRotation returns when the current key is due for rotation. It is the zero time if no key has been issued,
+// so implementations issue one immediately.
+func (k *AiVirtualKey) NextRotation() time.Time {
+	if k.Status.LastRotationTime == nil {
+		return time.Time{}
+	}
+	interval := DefaultKeyRotationInterval
+	if k.Spec.RotationInterval != nil {
+		interval = k.Spec.RotationInterval.Duration
+	}
+	return k.Status.LastRotationTime.Add(interval)
+}
+
+// +kubebuilder:object:root=true
+
+// AiVirtualKeyList contains a list of AiVirtualKey.
+type AiVirtualKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AiVirtualKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AiVirtualKey{}, &AiVirtualKeyList{})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAiVirtualKeySecretName(t *testing.T) {
+	key := &AiVirtualKey{ObjectMeta: metav1.ObjectMeta{Name: "batch-jobs"}}
+	if got := key.SecretName(); got != "batch-jobs-virtual-key" {
+		t.Errorf("SecretName() = %q, expected %q", got, "batch-jobs-virtual-key")
+	}
+	key.Spec.SecretName = "llm-credentials"
+	if got := key.SecretName(); got != "llm-credentials" {
+		t.Errorf("SecretName() = %q, expected %q", got, "llm-credentials")
+	}
+}
+
+func TestAiVirtualKeyNextRotation(t *testing.T) {
+	issued := metav1.NewTime(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name     string
+		key      AiVirtualKey
+		expected time.Time
+	}{
+		{name: "not issued", key: AiVirtualKey{}, expected: time.Time{}},
+		{
+			name:     "default interval",
+			key:      AiVirtualKey{Status: AiVirtualKeyStatus{LastRotationTime: &issued}},
+			expected: issued.Add(30 * 24 * time.Hour),
+		},
+		{
+			name: "custom interval",
+			key: AiVirtualKey{
+				Spec:   AiVirtualKeySpec{RotationInterval: &metav1.Duration{Duration: 24 * time.Hour}},
+				Status: AiVirtualKeyStatus{LastRotationTime: &issued},
+			},
+			expected: issued.Add(24 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.key.NextRotation(); !got.Equal(tt.expected) {
+				t.Errorf("NextRotation() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
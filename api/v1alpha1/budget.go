@@ -0,0 +1,43 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Exhausted reports whether spend, in US dollars as reported by the gateway for the current window, reaches the
+// budget.
+func (b *SpendBudget) Exhausted(spend float64) (bool, error) {
+	maxSpend, err := strconv.ParseFloat(b.MaxSpendUSD, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid maxSpendUSD %q: %w", b.MaxSpendUSD, err)
+	}
+	return spend >= maxSpend, nil
+}
+
+// WindowReset returns when the budget window containing now ends, for windows starting at start, usually the
+// creation of the policy. Implementations unblock the keys blocked in the window at that time.
+func (b *SpendBudget) WindowReset(start, now time.Time) time.Time {
+	window := b.Window.Duration
+	if window <= 0 || now.Before(start) {
+		return start.Add(window)
+	}
+	return start.Add((now.Sub(start)/window + 1) * window)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSpendBudgetExhausted(t *testing.T) {
+	budget := &SpendBudget{MaxSpendUSD: "12.50"}
+	for _, tt := range []struct {
+		spend float64
+		want  bool
+	}{
+		{spend: 0, want: false},
+		{spend: 12.49, want: false},
+		{spend: 12.5, want: true},
+		{spend: 100, want: true},
+	} {
+		got, err := budget.Exhausted(tt.spend)
+		if err != nil {
+			t.Fatalf("Exhausted(%v) error = %v", tt.spend, err)
+		}
+		if got != tt.want {
+			t.Errorf("Exhausted(%v) = %v, want %v", tt.spend, got, tt.want)
+		}
+	}
+
+	if _, err := (&SpendBudget{MaxSpendUSD: "ten"}).Exhausted(1); err == nil {
+		t.Error("Exhausted() with an invalid maxSpendUSD did not fail")
+	}
+}
+
+func TestSpendBudgetWindowReset(t *testing.T) {
+	start := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	budget := &SpendBudget{Window: metav1.Duration{Duration: 24 * time.Hour}}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{name: "before start", now: start.Add(-time.Hour), want: start.Add(24 * time.Hour)},
+		{name: "at start", now: start, want: start.Add(24 * time.Hour)},
+		{name: "within first window", now: start.Add(23 * time.Hour), want: start.Add(24 * time.Hour)},
+		{name: "at reset", now: start.Add(24 * time.Hour), want: start.Add(48 * time.Hour)},
+		{name: "later window", now: start.Add(75 * time.Hour), want: start.Add(96 * time.Hour)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := budget.WindowReset(start, tt.now); !got.Equal(tt.want) {
+				t.Errorf("WindowReset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
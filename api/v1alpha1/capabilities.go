@@ -0,0 +1,145 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"regexp"
+	"strings"
+)
+
+// modelVersionSuffix matches the version suffixes of pinned model names, e.g. "-20241022", "-2024-08-06" or
+// "-latest", which share the capabilities of the unpinned model.
+var modelVersionSuffix = regexp.MustCompile(`-(\d{8}|\d{4}-\d{2}-\d{2}|latest)$`)
+
+// catalogProviders maps providers hosting the models of another provider to the provider of the catalog entries.
+var catalogProviders = map[string]string{
+	"azure":     "openai",
+	"vertex_ai": "gemini",
+}
+
+// modelCatalog holds the capabilities of well-known models by "<provider>/<model>". Prices are the list prices
+// of the providers at the time of the release.
+var modelCatalog = map[string]ModelCapabilities{
+	"anthropic/claude-3-5-haiku": {
+		ContextWindow: 200000, MaxOutputTokens: 8192,
+		SupportsTools: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.0008", OutputCostPer1kTokensUSD: "0.004",
+	},
+	"anthropic/claude-3-5-sonnet": {
+		ContextWindow: 200000, MaxOutputTokens: 8192,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.003", OutputCostPer1kTokensUSD: "0.015",
+	},
+	"anthropic/claude-3-7-sonnet": {
+		ContextWindow: 200000, MaxOutputTokens: 64000,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.003", OutputCostPer1kTokensUSD: "0.015",
+	},
+	"anthropic/claude-3-haiku": {
+		ContextWindow: 200000, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.00025", OutputCostPer1kTokensUSD: "0.00125",
+	},
+	"anthropic/claude-3-opus": {
+		ContextWindow: 200000, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.015", OutputCostPer1kTokensUSD: "0.075",
+	},
+	"gemini/gemini-1.5-flash": {
+		ContextWindow: 1048576, MaxOutputTokens: 8192,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.000075", OutputCostPer1kTokensUSD: "0.0003",
+	},
+	"gemini/gemini-1.5-pro": {
+		ContextWindow: 2097152, MaxOutputTokens: 8192,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.00125", OutputCostPer1kTokensUSD: "0.005",
+	},
+	"mistral/codestral": {
+		ContextWindow: 256000, MaxOutputTokens: 4096, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.0003", OutputCostPer1kTokensUSD: "0.0009",
+	},
+	"mistral/mistral-large": {
+		ContextWindow: 128000, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.002", OutputCostPer1kTokensUSD: "0.006",
+	},
+	"mistral/mistral-small": {
+		ContextWindow: 32000, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.0002", OutputCostPer1kTokensUSD: "0.0006",
+	},
+	"openai/gpt-3.5-turbo": {
+		ContextWindow: 16385, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.0005", OutputCostPer1kTokensUSD: "0.0015",
+	},
+	"openai/gpt-4": {
+		ContextWindow: 8192, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.03", OutputCostPer1kTokensUSD: "0.06",
+	},
+	"openai/gpt-4-turbo": {
+		ContextWindow: 128000, MaxOutputTokens: 4096,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.01", OutputCostPer1kTokensUSD: "0.03",
+	},
+	"openai/gpt-4o": {
+		ContextWindow: 128000, MaxOutputTokens: 16384,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.0025", OutputCostPer1kTokensUSD: "0.01",
+	},
+	"openai/gpt-4o-mini": {
+		ContextWindow: 128000, MaxOutputTokens: 16384,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.00015", OutputCostPer1kTokensUSD: "0.0006",
+	},
+	"openai/o1": {
+		ContextWindow: 200000, MaxOutputTokens: 100000,
+		SupportsTools: true, SupportsVision: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.015", OutputCostPer1kTokensUSD: "0.06",
+	},
+	"openai/o3-mini": {
+		ContextWindow: 200000, MaxOutputTokens: 100000,
+		SupportsTools: true, SupportsStreaming: true,
+		InputCostPer1kTokensUSD: "0.0011", OutputCostPer1kTokensUSD: "0.0044",
+	},
+}
+
+// ResolveCapabilities returns the capabilities of the model: its declared capabilities, or those of the bundled
+// catalog for its provider and name, ignoring version suffixes. It returns nil for unknown models.
+// Implementations report the result in status.models[].capabilities.
+func (m AiModel) ResolveCapabilities() *ModelCapabilities {
+	if m.Capabilities != nil {
+		return m.Capabilities.DeepCopy()
+	}
+	if m.IsWildcard() {
+		return nil
+	}
+
+	provider := m.Provider
+	if catalogProvider, ok := catalogProviders[provider]; ok {
+		provider = catalogProvider
+	}
+	name := modelVersionSuffix.ReplaceAllString(strings.TrimPrefix(m.Name, provider+"/"), "")
+
+	capabilities, ok := modelCatalog[provider+"/"+name]
+	if !ok {
+		return nil
+	}
+	return &capabilities
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestResolveCapabilities(t *testing.T) {
+	declared := &ModelCapabilities{ContextWindow: 32768, SupportsStreaming: true}
+	tests := []struct {
+		name          string
+		model         AiModel
+		contextWindow int32
+	}{
+		{name: "catalog model", model: AiModel{Provider: "openai", Name: "gpt-4o"}, contextWindow: 128000},
+		{name: "pinned version", model: AiModel{Provider: "anthropic", Name: "claude-3-5-sonnet-20241022"}, contextWindow: 200000},
+		{name: "provider prefix", model: AiModel{Provider: "gemini", Name: "gemini/gemini-1.5-pro-latest"}, contextWindow: 2097152},
+		{name: "azure hosted", model: AiModel{Provider: "azure", Name: "gpt-4o-2024-08-06"}, contextWindow: 128000},
+		{name: "declared", model: AiModel{Provider: "openai", Name: "gpt-4o", Capabilities: declared}, contextWindow: 32768},
+		{name: "unknown model", model: AiModel{Provider: "ollama", Name: "llama3"}},
+		{name: "wildcard", model: AiModel{Provider: "openai", Name: "*"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.model.ResolveCapabilities()
+			if tt.contextWindow == 0 {
+				if got != nil {
+					t.Errorf("ResolveCapabilities() = %+v, expected nil", got)
+				}
+				return
+			}
+			if got == nil || got.ContextWindow != tt.contextWindow {
+				t.Errorf("ResolveCapabilities() = %+v, expected context window %d", got, tt.contextWindow)
+			}
+		})
+	}
+
+	if got := (AiModel{Provider: "openai", Name: "gpt-4o", Capabilities: declared}).ResolveCapabilities(); got == declared {
+		t.Error("ResolveCapabilities() returned the declared capabilities instead of a copy")
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+)
+
+// ConfigChecksumAnnotation is set on the pod template of a gateway Deployment to the ConfigChecksum
+// of the generated gateway configuration. Because the pod template changes whenever the configuration
+// changes, the pods are restarted and pick up the new configuration.
+const ConfigChecksumAnnotation = "agentic-layer.ai/config-checksum"
+
+// ConfigChecksum returns a stable SHA-256 checksum of the data of a ConfigMap or Secret.
+// The result does not depend on the iteration order of the map.
+func ConfigChecksum(data map[string]string) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	hash := sha256.New()
+	for _, key := range keys {
+		// Separate keys and values with NUL so that moving characters between them changes the checksum.
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(data[key]))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// NewConfigReference returns the status.configRef of a gateway for the rendered configuration data stored in the
+// resource with the given name and resource version. The kind follows spec.configStorage, see
+// StoresConfigInSecret.
+func NewConfigReference(g *AiGateway, name, resourceVersion string, data map[string]string) *ConfigReference {
+	kind := ConfigStorageConfigMap
+	if g.Spec.StoresConfigInSecret() {
+		kind = ConfigStorageSecret
+	}
+	return &ConfigReference{
+		Kind:               kind,
+		Name:               name,
+		ResourceVersion:    resourceVersion,
+		Checksum:           ConfigChecksum(data),
+		ObservedGeneration: g.Generation,
+	}
+}
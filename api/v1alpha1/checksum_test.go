@@ -0,0 +1,60 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConfigChecksum(t *testing.T) {
+	config := map[string]string{"config.yaml": "model_list: []", "extra.yaml": "a: b"}
+	checksum := ConfigChecksum(config)
+
+	if got := ConfigChecksum(map[string]string{"extra.yaml": "a: b", "config.yaml": "model_list: []"}); got != checksum {
+		t.Errorf("ConfigChecksum() depends on key order: %q != %q", got, checksum)
+	}
+	if got := ConfigChecksum(map[string]string{"config.yaml": "model_list: []", "extra.yaml": "a: c"}); got == checksum {
+		t.Errorf("ConfigChecksum() did not change with the data")
+	}
+	if got := ConfigChecksum(map[string]string{"config.yamlm": "odel_list: []", "extra.yaml": "a: b"}); got == checksum {
+		t.Errorf("ConfigChecksum() did not change when moving characters from value to key")
+	}
+}
+
+func TestNewConfigReference(t *testing.T) {
+	config := map[string]string{"config.yaml": "model_list: []"}
+	g := &AiGateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Generation: 3}}
+
+	want := &ConfigReference{
+		Kind:               ConfigStorageSecret,
+		Name:               "gw-config",
+		ResourceVersion:    "42",
+		Checksum:           ConfigChecksum(config),
+		ObservedGeneration: 3,
+	}
+	if got := NewConfigReference(g, "gw-config", "42", config); !reflect.DeepEqual(got, want) {
+		t.Errorf("NewConfigReference() = %+v, want %+v", got, want)
+	}
+
+	g.Spec.ConfigStorage = ConfigStorageConfigMap
+	if got := NewConfigReference(g, "gw-config", "42", config); got.Kind != ConfigStorageConfigMap {
+		t.Errorf("NewConfigReference().Kind = %q, want %q", got.Kind, ConfigStorageConfigMap)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultClassAnnotation marks an AiGatewayClass as the class of AiGateways without spec.aiGatewayClassName, if
+// set to "true".
+const DefaultClassAnnotation = "aigateway.kubernetes.io/is-default-class"
+
+// ClassNameIndexField is the field index implementations register for AiGateways and ClusterAiGateways, keyed
+// by spec.aiGatewayClassName. A watch on AiGatewayClasses maps a changed class to its gateways through this
+// index, so that gateways are picked up or released when the controller of their class changes.
+const ClassNameIndexField = ".spec.aiGatewayClassName"
+
+// HandledBy reports whether the gateways of the class are reconciled by the controller with the given name,
+// the --controller-name of an implementation operator. Implementations resolve the class of every gateway and
+// ignore gateways whose class does not exist or names another controller, without touching their status, so
+// that several gateway implementations can share a cluster like Gateway API controllers do.
+func (c *AiGatewayClass) HandledBy(controllerName string) bool {
+	return controllerName != "" && c.Spec.Controller == controllerName
+}
+
+// AcceptedCondition returns the Accepted condition of the class for the operator with the given controller
+// name: True if the class is HandledBy it and False with reason Unsupported otherwise.
+func (c *AiGatewayClass) AcceptedCondition(controllerName string) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               AiGatewayClassConditionAccepted,
+		Status:             metav1.ConditionTrue,
+		Reason:             AiGatewayClassReasonAccepted,
+		Message:            fmt.Sprintf("Gateways of this class are handled by %s", controllerName),
+		ObservedGeneration: c.Generation,
+	}
+	if !c.HandledBy(controllerName) {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = AiGatewayClassReasonUnsupported
+		condition.Message = fmt.Sprintf("Controller %q is not supported, the operator in this cluster handles %q",
+			c.Spec.Controller, controllerName)
+	}
+	return condition
+}
+
+// DefaultClassNamespace is the class namespace unless configured otherwise with --class-namespace: the namespace
+// config/default deploys the operator to.
+const DefaultClassNamespace = "ai-gateway-operator-system"
+
+// ResolveClass returns the class named by spec.aiGatewayClassName of a gateway, or nil if there is none.
+// AiGatewayClasses are namespaced, but gateways reference them by name only, so they are only resolved in the
+// class namespace of the operator, whatever the namespace of the gateway. Classes in other namespaces are ignored,
+// so that users able to create classes in their own namespace cannot shadow the classes of the platform and
+// their guardrails, e.g. spec.allowedProviders.
+func ResolveClass(classes []AiGatewayClass, name, classNamespace string) *AiGatewayClass {
+	for i := range classes {
+		if classes[i].Namespace == classNamespace && classes[i].Name == name {
+			return &classes[i]
+		}
+	}
+	return nil
+}
+
+// IsDefault reports whether the class is marked with DefaultClassAnnotation.
+func (c *AiGatewayClass) IsDefault() bool {
+	return c.Annotations[DefaultClassAnnotation] == "true"
+}
+
+// ResolveDefaultClass returns the class AiGateways without spec.aiGatewayClassName default to, or nil if no class
+// of the class namespace is marked as default, see ResolveClass. If several classes are marked, the oldest wins,
+// and the name decides between classes created in the same second, so the defaulting webhook and the reconcilers
+// setting the Default condition agree.
+func ResolveDefaultClass(classes []AiGatewayClass, classNamespace string) *AiGatewayClass {
+	var defaultClass *AiGatewayClass
+	for i := range classes {
+		class := &classes[i]
+		if class.Namespace != classNamespace || !class.IsDefault() {
+			continue
+		}
+		if defaultClass == nil || class.CreationTimestamp.Before(&defaultClass.CreationTimestamp) ||
+			class.CreationTimestamp.Equal(&defaultClass.CreationTimestamp) && class.Name < defaultClass.Name {
+			defaultClass = class
+		}
+	}
+	return defaultClass
+}
+
+// DefaultCondition returns the Default condition of a class marked as default, given the resolved default class
+// of the cluster: True if it is the class, and False with reason Superseded otherwise.
+func (c *AiGatewayClass) DefaultCondition(defaultClass *AiGatewayClass) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               AiGatewayClassConditionDefault,
+		Status:             metav1.ConditionTrue,
+		Reason:             AiGatewayClassReasonDefault,
+		Message:            "AiGateways without aiGatewayClassName use this class",
+		ObservedGeneration: c.Generation,
+	}
+	if defaultClass == nil || defaultClass.Namespace != c.Namespace || defaultClass.Name != c.Name {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = AiGatewayClassReasonSuperseded
+		condition.Message = "Another AiGatewayClass is marked as default as well; AiGateways without " +
+			"aiGatewayClassName use the oldest"
+		if defaultClass != nil {
+			condition.Message = fmt.Sprintf("AiGatewayClass %q is marked as default as well and is older; "+
+				"AiGateways without aiGatewayClassName use it", defaultClass.Name)
+		}
+	}
+	return condition
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestHandledBy(t *testing.T) {
+	class := &AiGatewayClass{Spec: AiGatewayClassSpec{Controller: "litellm.agentic-layer.ai/controller"}}
+
+	tests := map[string]struct {
+		controllerName string
+		want           bool
+	}{
+		"same controller":    {controllerName: "litellm.agentic-layer.ai/controller", want: true},
+		"other controller":   {controllerName: "envoy.agentic-layer.ai/controller", want: false},
+		"no controller name": {controllerName: "", want: false},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := class.HandledBy(tt.controllerName); got != tt.want {
+				t.Errorf("HandledBy(%q) = %v, want %v", tt.controllerName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAcceptedCondition(t *testing.T) {
+	class := &AiGatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       AiGatewayClassSpec{Controller: "litellm.agentic-layer.ai/controller"},
+	}
+
+	accepted := class.AcceptedCondition("litellm.agentic-layer.ai/controller")
+	if accepted.Status != metav1.ConditionTrue || accepted.Reason != AiGatewayClassReasonAccepted ||
+		accepted.ObservedGeneration != 2 {
+		t.Errorf("AcceptedCondition() = %+v, want True with reason Accepted for generation 2", accepted)
+	}
+
+	unsupported := class.AcceptedCondition("envoy.agentic-layer.ai/controller")
+	if unsupported.Status != metav1.ConditionFalse || unsupported.Reason != AiGatewayClassReasonUnsupported {
+		t.Errorf("AcceptedCondition() = %+v, want False with reason Unsupported", unsupported)
+	}
+}
+
+func TestResolveDefaultClass(t *testing.T) {
+	older := metav1.NewTime(metav1.Now().Add(-time.Hour))
+	newer := metav1.Now()
+	class := func(name string, created metav1.Time, isDefault bool) AiGatewayClass {
+		c := AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Namespace: "platform", Name: name, CreationTimestamp: created}}
+		if isDefault {
+			c.Annotations = map[string]string{DefaultClassAnnotation: "true"}
+		}
+		return c
+	}
+
+	tests := map[string]struct {
+		classes []AiGatewayClass
+		want    string
+	}{
+		"no default class": {
+			classes: []AiGatewayClass{class("litellm", older, false)},
+		},
+		"single default class": {
+			classes: []AiGatewayClass{class("litellm", older, false), class("envoy", newer, true)},
+			want:    "envoy",
+		},
+		"oldest default class wins": {
+			classes: []AiGatewayClass{class("envoy", newer, true), class("litellm", older, true)},
+			want:    "litellm",
+		},
+		"name decides between classes of the same age": {
+			classes: []AiGatewayClass{class("litellm", older, true), class("envoy", older, true)},
+			want:    "envoy",
+		},
+		"classes outside the class namespace are ignored": {
+			classes: []AiGatewayClass{class("envoy", newer, true), {ObjectMeta: metav1.ObjectMeta{
+				Namespace: "team-a", Name: "litellm", CreationTimestamp: older,
+				Annotations: map[string]string{DefaultClassAnnotation: "true"},
+			}}},
+			want: "envoy",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ResolveDefaultClass(tt.classes, "platform")
+			if got == nil && tt.want != "" || got != nil && got.Name != tt.want {
+				t.Errorf("ResolveDefaultClass() = %v, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultCondition(t *testing.T) {
+	litellm := &AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "litellm", Generation: 2}}
+	envoy := &AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "envoy"}}
+
+	condition := litellm.DefaultCondition(litellm)
+	if condition.Status != metav1.ConditionTrue || condition.Reason != AiGatewayClassReasonDefault ||
+		condition.ObservedGeneration != 2 {
+		t.Errorf("DefaultCondition() of the default class = %+v", condition)
+	}
+	condition = envoy.DefaultCondition(litellm)
+	if condition.Status != metav1.ConditionFalse || condition.Reason != AiGatewayClassReasonSuperseded {
+		t.Errorf("DefaultCondition() of a superseded class = %+v", condition)
+	}
+}
+
+func TestResolveClass(t *testing.T) {
+	class := func(namespace, name string) AiGatewayClass {
+		return AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	classes := []AiGatewayClass{class("team-a", "litellm"), class("platform", "litellm"), class("team-a", "kong")}
+
+	tests := map[string]struct {
+		name          string
+		wantNamespace string
+	}{
+		"class in the class namespace":    {name: "litellm", wantNamespace: "platform"},
+		"class in another namespace only": {name: "kong"},
+		"missing class":                   {name: "envoy"},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := ResolveClass(classes, tt.name, "platform")
+			if got == nil && tt.wantNamespace != "" ||
+				got != nil && (got.Name != tt.name || got.Namespace != tt.wantNamespace) {
+				t.Errorf("ResolveClass(%q) = %v, want %s/%s", tt.name, got, tt.wantNamespace, tt.name)
+			}
+		})
+	}
+}
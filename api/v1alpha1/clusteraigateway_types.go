@@ -0,0 +1,87 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterAiGatewaySpec defines a shared gateway and the namespaces it is exposed to.
+type ClusterAiGatewaySpec struct {
+	AiGatewaySpec `json:",inline"`
+
+	// Namespace is the namespace the gateway is deployed into. Secrets referenced by the gateway are resolved
+	// in this namespace. Defaults to the system namespace configured for the implementation operator.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// NamespaceSelector selects the namespaces whose workloads may use the gateway. The gateway is published
+	// into their discovery ConfigMap, which replaces spec.discovery of namespaced AiGateways.
+	// The gateway is only reachable from its own namespace if not set.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+
+// ClusterAiGateway is the Schema for the clusteraigateways API.
+// It is a gateway shared by several namespaces, e.g. one central gateway per cluster run by a platform team.
+type ClusterAiGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAiGatewaySpec `json:"spec,omitempty"`
+	Status AiGatewayStatus      `json:"status,omitempty"`
+}
+
+// AsAiGateway returns the gateway as an AiGateway in the namespace it is deployed into, given the
+// system namespace used if spec.namespace is not set. Implementations render ClusterAiGateways through it,
+// so both kinds share the same configuration logic.
+func (c *ClusterAiGateway) AsAiGateway(systemNamespace string) *AiGateway {
+	namespace := c.Spec.Namespace
+	if namespace == "" {
+		namespace = systemNamespace
+	}
+	return &AiGateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        c.Name,
+			Namespace:   namespace,
+			Labels:      c.Labels,
+			Annotations: c.Annotations,
+			Generation:  c.Generation,
+		},
+		Spec:   *c.Spec.AiGatewaySpec.DeepCopy(),
+		Status: *c.Status.DeepCopy(),
+	}
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterAiGatewayList contains a list of ClusterAiGateway.
+type ClusterAiGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAiGateway `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterAiGateway{}, &ClusterAiGatewayList{})
+}
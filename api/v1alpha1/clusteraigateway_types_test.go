@@ -0,0 +1,46 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestClusterAiGatewayAsAiGateway(t *testing.T) {
+	gateway := &ClusterAiGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+		Spec: ClusterAiGatewaySpec{
+			AiGatewaySpec: AiGatewaySpec{AiModels: []AiModel{{Name: "gpt-4o", Provider: "openai"}}},
+		},
+	}
+
+	if got := gateway.AsAiGateway("ai-system"); got.Namespace != "ai-system" || got.Name != "platform" {
+		t.Errorf("AsAiGateway() = %s/%s, expected ai-system/platform", got.Namespace, got.Name)
+	}
+
+	gateway.Spec.Namespace = "platform-gateways"
+	got := gateway.AsAiGateway("ai-system")
+	if got.Namespace != "platform-gateways" {
+		t.Errorf("AsAiGateway().Namespace = %q, expected %q", got.Namespace, "platform-gateways")
+	}
+	got.Spec.AiModels[0].Name = "changed"
+	if gateway.Spec.AiModels[0].Name != "gpt-4o" {
+		t.Errorf("AsAiGateway() shares the spec with the ClusterAiGateway")
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// EnvironReferencePrefix is the prefix of LiteLLM configuration values read from an environment variable.
+const EnvironReferencePrefix = "os.environ/"
+
+var (
+	// envVarNameInvalid matches the characters not allowed in the environment variable names of credentials.
+	envVarNameInvalid = regexp.MustCompile(`[^A-Z0-9_]+`)
+	// credentialName matches the names of settings holding credentials, e.g. "master_key" or "langfuse_secret".
+	credentialName = regexp.MustCompile(`(?i)(^|_)(api_?key|master_?key|password|secret|token)$`)
+	// credentialValue matches values that are credentials regardless of their name, e.g. OpenAI API keys.
+	credentialValue = regexp.MustCompile(`(?i)^(sk-[a-z0-9_-]{8,}|bearer\s+\S+)`)
+)
+
+// CredentialEnvVarName returns the name of the environment variable implementations map the Secret key to, e.g.
+// "SECRET_OPENAI_API_KEY_4F3F7D5B" for key "api-key" of Secret "openai". The suffix is derived from the Secret
+// name and key, so that references like "a-b" and "a_b" don't map to the same variable.
+func CredentialEnvVarName(ref *corev1.SecretKeySelector) string {
+	sum := sha256.Sum256([]byte(ref.Name + "/" + ref.Key))
+	name := envVarNameInvalid.ReplaceAllString(strings.ToUpper(ref.Name+"_"+ref.Key), "_")
+	return "SECRET_" + name + "_" + strings.ToUpper(hex.EncodeToString(sum[:4]))
+}
+
+// EnvironReference returns the value implementations write to the generated configuration instead of the
+// credential held by the Secret key, e.g. "os.environ/SECRET_OPENAI_API_KEY_4F3F7D5B". Implementations never inline
+// credentials into the configuration, it only holds these references; the credentials are passed to the gateway
+// pods as the CredentialEnvVars.
+func EnvironReference(ref *corev1.SecretKeySelector) string {
+	return EnvironReferencePrefix + CredentialEnvVarName(ref)
+}
+
+// CredentialEnvVars returns the environment variables of the gateway pods holding the credentials of the
+// configuration, sourced from their Secret keys and sorted by name: the API keys of the models, the master key,
+// the database and Redis connection strings, and the keys of MCP servers, hooks and observability callbacks.
+func (s *AiGatewaySpec) CredentialEnvVars() []corev1.EnvVar {
+	var refs []*corev1.SecretKeySelector
+	if s.Database != nil {
+		refs = append(refs, &s.Database.SecretRef)
+	}
+	refs = append(refs, s.RedisRef, s.MasterKeySecretRef)
+	for _, model := range s.AiModels {
+		refs = append(refs, model.APIKeySecretRef)
+		if model.VertexAI != nil {
+			refs = append(refs, model.VertexAI.CredentialsSecretRef)
+		}
+	}
+	for _, server := range s.MCPServers {
+		refs = append(refs, server.AuthorizationSecretRef)
+	}
+	if s.Hooks != nil {
+		for _, hook := range []*HookEndpoint{s.Hooks.PreCall, s.Hooks.PostCall} {
+			if hook != nil {
+				refs = append(refs, hook.AuthorizationSecretRef)
+			}
+		}
+	}
+	if s.Observability != nil {
+		for _, callback := range s.Observability.Callbacks {
+			if callback.Langfuse != nil {
+				refs = append(refs, &callback.Langfuse.PublicKeySecretRef, &callback.Langfuse.SecretKeySecretRef)
+			}
+			if callback.Helicone != nil {
+				refs = append(refs, &callback.Helicone.APIKeySecretRef)
+			}
+			if callback.Webhook != nil {
+				refs = append(refs, callback.Webhook.AuthorizationSecretRef)
+			}
+		}
+	}
+
+	var envVars []corev1.EnvVar
+	for _, ref := range refs {
+		if ref == nil || ref.Name == "" || ref.Key == "" {
+			continue
+		}
+		envVars = append(envVars, corev1.EnvVar{
+			Name: CredentialEnvVarName(ref),
+			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: ref.LocalObjectReference, Key: ref.Key,
+			}},
+		})
+	}
+	slices.SortFunc(envVars, func(a, b corev1.EnvVar) int { return strings.Compare(a.Name, b.Name) })
+	return slices.CompactFunc(envVars, func(a, b corev1.EnvVar) bool { return a.Name == b.Name })
+}
+
+// LiteralCredentials returns the paths of the settings holding a literal credential instead of an
+// EnvironReference, e.g. "litellm_settings.master_key", sorted. Settings are credentials if their name ends with
+// api_key, master_key, password, secret or token, or if their value looks like an API key or a bearer token.
+func LiteralCredentials(settings map[string]map[string]any) []string {
+	var paths []string
+	var visit func(path, name string, value any)
+	visit = func(path, name string, value any) {
+		switch v := value.(type) {
+		case map[string]any:
+			for key, nested := range v {
+				visit(path+"."+key, key, nested)
+			}
+		case []any:
+			for i, nested := range v {
+				visit(fmt.Sprintf("%s[%d]", path, i), name, nested)
+			}
+		case string:
+			if v != "" && !strings.HasPrefix(v, EnvironReferencePrefix) &&
+				(credentialName.MatchString(name) || credentialValue.MatchString(v)) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	for section, values := range settings {
+		for key, value := range values {
+			visit(section+"."+key, key, value)
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestCredentialEnvVarName(t *testing.T) {
+	apiKey := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "openai"}, Key: "api-key"}
+	if got, want := CredentialEnvVarName(apiKey), "SECRET_OPENAI_API_KEY_4F3F7D5B"; got != want {
+		t.Errorf("CredentialEnvVarName() = %q, want %q", got, want)
+	}
+	if got, want := EnvironReference(apiKey), "os.environ/SECRET_OPENAI_API_KEY_4F3F7D5B"; got != want {
+		t.Errorf("EnvironReference() = %q, want %q", got, want)
+	}
+
+	similar := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "openai"}, Key: "api_key"}
+	if CredentialEnvVarName(similar) == CredentialEnvVarName(apiKey) {
+		t.Errorf("CredentialEnvVarName() maps keys api-key and api_key to the same variable")
+	}
+}
+
+func TestCredentialEnvVars(t *testing.T) {
+	spec := &AiGatewaySpec{
+		MasterKeySecretRef: secretKeyRef("master"),
+		AiModels: []AiModel{
+			{Name: "gpt-4o", Provider: "openai", APIKeySecretRef: secretKeyRef("openai")},
+			{Name: "gpt-4o-mini", Provider: "openai", APIKeySecretRef: secretKeyRef("openai")},
+			{Name: "llama3", Provider: "ollama"},
+		},
+	}
+
+	var names []string
+	for _, envVar := range spec.CredentialEnvVars() {
+		names = append(names, envVar.Name)
+		if envVar.ValueFrom == nil || envVar.ValueFrom.SecretKeyRef == nil {
+			t.Errorf("CredentialEnvVars() returned %s without a Secret key reference", envVar.Name)
+		}
+	}
+	expected := []string{CredentialEnvVarName(secretKeyRef("master")), CredentialEnvVarName(secretKeyRef("openai"))}
+	if !reflect.DeepEqual(names, expected) {
+		t.Errorf("CredentialEnvVars() = %v, expected %v", names, expected)
+	}
+}
+
+func TestLiteralCredentials(t *testing.T) {
+	settings := map[string]map[string]any{
+		SettingsKeyLiteLLM: {
+			"master_key":         "sk-1234567890abcdef",
+			"langfuse_secret":    "os.environ/LANGFUSE_SECRET",
+			"max_tokens":         "4096",
+			"drop_params":        true,
+			"success_callback":   []any{"langfuse"},
+			"extra_headers":      map[string]any{"Authorization": "Bearer abc"},
+			"default_team_token": "",
+		},
+		SettingsKeyRouter: {
+			"redis_password": "hunter2",
+			"fallbacks":      []any{map[string]any{"api_key": "literal"}},
+		},
+	}
+
+	expected := []string{
+		"litellm_settings.extra_headers.Authorization",
+		"litellm_settings.master_key",
+		"router_settings.fallbacks[0].api_key",
+		"router_settings.redis_password",
+	}
+	if got := LiteralCredentials(settings); !reflect.DeepEqual(got, expected) {
+		t.Errorf("LiteralCredentials() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"strings"
+)
+
+// DiscoveryConfigMapName is the name of the ConfigMap implementations publish into namespaces to let
+// agent runtimes discover the AiGateway serving the namespace. The gateway in the namespace itself takes
+// precedence over gateways publishing into it via spec.discovery or a ClusterAiGateway namespaceSelector;
+// among those, the oldest gateway wins.
+const DiscoveryConfigMapName = "ai-gateway-discovery"
+
+// Keys of the discovery ConfigMap.
+const (
+	// DiscoveryGatewayKey holds the AiGateway as "<namespace>/<name>".
+	DiscoveryGatewayKey = "gateway"
+	// DiscoveryURLKey holds the cluster-local URL of the gateway, see AiGatewayStatus.URL.
+	DiscoveryURLKey = "url"
+	// DiscoveryModelsKey holds the public model names of the gateway, one per line.
+	DiscoveryModelsKey = "models"
+)
+
+// PublicModelNames returns the sorted model names clients can request from the gateway: the public names of
+// its models, the aliases of its traffic splits and the names of its model groups. Wildcard models are omitted.
+func (g *AiGateway) PublicModelNames() []string {
+	var names []string
+	for _, model := range g.Spec.AiModels {
+		if !model.IsWildcard() {
+			names = append(names, model.PublicName())
+		}
+	}
+	for _, split := range g.Spec.TrafficSplits {
+		names = append(names, split.Alias)
+	}
+	for _, group := range g.Spec.ModelGroups {
+		names = append(names, group.Name)
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// DiscoveryData returns the data of the discovery ConfigMap for the gateway.
+func DiscoveryData(g *AiGateway) map[string]string {
+	return map[string]string{
+		DiscoveryGatewayKey: g.Namespace + "/" + g.Name,
+		DiscoveryURLKey:     g.Status.URL,
+		DiscoveryModelsKey:  strings.Join(g.PublicModelNames(), "\n"),
+	}
+}
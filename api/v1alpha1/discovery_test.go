@@ -0,0 +1,49 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"maps"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDiscoveryData(t *testing.T) {
+	gateway := &AiGateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "agents", Name: "gateway"},
+		Spec: AiGatewaySpec{
+			AiModels: []AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "claude-3-5-sonnet", Provider: "anthropic", Alias: "claude"},
+				{Name: "*", Provider: "mistral"},
+			},
+			TrafficSplits: []TrafficSplit{{Alias: "chat"}},
+			ModelGroups:   []ModelGroup{{Name: "frontier", Models: []string{"gpt-4o", "claude"}}},
+		},
+		Status: AiGatewayStatus{URL: "http://gateway.agents.svc.cluster.local:4000"},
+	}
+
+	expected := map[string]string{
+		DiscoveryGatewayKey: "agents/gateway",
+		DiscoveryURLKey:     "http://gateway.agents.svc.cluster.local:4000",
+		DiscoveryModelsKey:  "chat\nclaude\nfrontier\ngpt-4o",
+	}
+	if got := DiscoveryData(gateway); !maps.Equal(got, expected) {
+		t.Errorf("DiscoveryData() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ErrorCategory classifies errors of AI gateways into a small, fixed set of categories.
+// Implementations use the same categories for status condition reasons, Event reasons
+// and metric labels, so dashboards and alerts work across all gateway implementations.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryAuth covers rejected or missing provider credentials.
+	ErrorCategoryAuth ErrorCategory = "Auth"
+	// ErrorCategoryQuota covers rate limits, exhausted budgets and provider quotas.
+	ErrorCategoryQuota ErrorCategory = "Quota"
+	// ErrorCategoryProviderDown covers unreachable or failing upstream providers.
+	ErrorCategoryProviderDown ErrorCategory = "ProviderDown"
+	// ErrorCategoryConfig covers invalid or unsatisfiable gateway configuration.
+	ErrorCategoryConfig ErrorCategory = "Config"
+	// ErrorCategoryClient covers invalid requests sent by gateway clients.
+	ErrorCategoryClient ErrorCategory = "Client"
+)
+
+// Reason returns the category as a condition or Event reason (e.g. "ProviderDownError").
+func (c ErrorCategory) Reason() string {
+	return string(c) + "Error"
+}
+
+// MetricLabel returns the category as a metric label value (e.g. "provider_down").
+func (c ErrorCategory) MetricLabel() string {
+	var b strings.Builder
+	for i, r := range string(c) {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToLower(b.String())
+}
+
+// ClassifyHTTPStatus maps the HTTP status code of a failed provider call to an ErrorCategory.
+// It returns an empty category for status codes that do not indicate an error.
+func ClassifyHTTPStatus(statusCode int) ErrorCategory {
+	switch {
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ErrorCategoryAuth
+	case statusCode == http.StatusTooManyRequests || statusCode == http.StatusPaymentRequired:
+		return ErrorCategoryQuota
+	case statusCode == http.StatusRequestTimeout || statusCode >= http.StatusInternalServerError:
+		return ErrorCategoryProviderDown
+	case statusCode >= http.StatusBadRequest:
+		return ErrorCategoryClient
+	default:
+		return ""
+	}
+}
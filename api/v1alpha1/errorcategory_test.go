@@ -0,0 +1,50 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestClassifyHTTPStatus(t *testing.T) {
+	tests := map[int]ErrorCategory{
+		200: "",
+		400: ErrorCategoryClient,
+		401: ErrorCategoryAuth,
+		403: ErrorCategoryAuth,
+		404: ErrorCategoryClient,
+		408: ErrorCategoryProviderDown,
+		429: ErrorCategoryQuota,
+		500: ErrorCategoryProviderDown,
+		503: ErrorCategoryProviderDown,
+	}
+	for statusCode, expected := range tests {
+		if got := ClassifyHTTPStatus(statusCode); got != expected {
+			t.Errorf("ClassifyHTTPStatus(%d) = %q, expected %q", statusCode, got, expected)
+		}
+	}
+}
+
+func TestErrorCategoryFormats(t *testing.T) {
+	if got := ErrorCategoryProviderDown.Reason(); got != "ProviderDownError" {
+		t.Errorf("Reason() = %q, expected %q", got, "ProviderDownError")
+	}
+	if got := ErrorCategoryProviderDown.MetricLabel(); got != "provider_down" {
+		t.Errorf("MetricLabel() = %q, expected %q", got, "provider_down")
+	}
+	if got := ErrorCategoryAuth.MetricLabel(); got != "auth" {
+		t.Errorf("MetricLabel() = %q, expected %q", got, "auth")
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Reasons of the Events implementations record on an AiGateway for reconciliation milestones,
+// so that `kubectl describe` shows the same story for every gateway implementation.
+// Failures are recorded as Warning Events with the reason of their ErrorCategory instead.
+const (
+	// EventReasonConfigRendered is recorded when the gateway configuration has been rendered
+	// into its ConfigMap or Secret.
+	EventReasonConfigRendered = "ConfigRendered"
+	// EventReasonDeploymentUpdated is recorded when the gateway Deployment has been created or updated.
+	EventReasonDeploymentUpdated = "DeploymentUpdated"
+	// EventReasonRolloutComplete is recorded when all gateway pods run the current configuration.
+	EventReasonRolloutComplete = "RolloutComplete"
+	// EventReasonValidationFailed is recorded as a Warning when the gateway cannot be reconciled
+	// because its spec is invalid, e.g. a referenced Secret key does not exist.
+	EventReasonValidationFailed = "ValidationFailed"
+)
+
+// Reasons of the Events the provider catalog sync of this operator records on the AiGateways using a provider.
+const (
+	// EventReasonModelUnavailable is recorded as a Warning when a model of the gateway is no longer offered by
+	// its provider, e.g. because it has been deprecated and shut down.
+	EventReasonModelUnavailable = "ModelUnavailable"
+	// EventReasonNewModelsAvailable is recorded when a provider used by the gateway offers new models.
+	EventReasonNewModelsAvailable = "NewModelsAvailable"
+)
+
+// Reasons of the Events implementations record on an AiRateLimitPolicy when they block or unblock virtual keys
+// because of its budget.
+const (
+	// EventReasonKeyBlocked is recorded as a Warning when a virtual key has been disabled via the admin API of
+	// the gateway because its consumer exhausted the budget.
+	EventReasonKeyBlocked = "KeyBlocked"
+	// EventReasonKeyUnblocked is recorded when a blocked virtual key has been enabled again at the reset of the
+	// budget window.
+	EventReasonKeyUnblocked = "KeyUnblocked"
+)
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Kinds of baselines referenced by ExtendsRef.
+const (
+	ExtendsKindAiGateway      = "AiGateway"
+	ExtendsKindAiModelLibrary = "AiModelLibrary"
+)
+
+// ExtendableFromAnnotation lists the namespaces whose AiGateways may extend the annotated AiGateway,
+// comma-separated, or "*" for all namespaces, e.g. `agentic-layer.ai/extendable-from: "team-a,team-b"`. AiGateways
+// inherit the settings of their baseline, including its Secret references, so a baseline can only be extended from
+// its own namespace unless it opts in.
+const ExtendableFromAnnotation = "agentic-layer.ai/extendable-from"
+
+// ExtendableFrom reports whether AiGateways of the namespace may extend the gateway, see ExtendableFromAnnotation.
+func (g *AiGateway) ExtendableFrom(namespace string) bool {
+	if namespace == g.Namespace {
+		return true
+	}
+	namespaces := strings.Split(g.Annotations[ExtendableFromAnnotation], ",")
+	for i := range namespaces {
+		namespaces[i] = strings.TrimSpace(namespaces[i])
+	}
+	return slices.Contains(namespaces, "*") || slices.Contains(namespaces, namespace)
+}
+
+// InheritFrom sets all fields of the spec that are not set to the values of the base spec and merges the models
+// of both specs with MergeModels. Fields are inherited as a whole, e.g. a gateway setting spec.logging does not
+// inherit any logging setting of its base. The reference to the base itself and spec.suspend are kept, so that
+// suspending a base does not suspend the gateways extending it.
+func (s *AiGatewaySpec) InheritFrom(base *AiGatewaySpec) {
+	models := MergeModels(base.AiModels, s.AiModels)
+	extends, suspend := s.Extends, s.Suspend
+
+	spec := reflect.ValueOf(s).Elem()
+	baseSpec := reflect.ValueOf(base.DeepCopy()).Elem()
+	for i := range spec.NumField() {
+		if spec.Field(i).IsZero() {
+			spec.Field(i).Set(baseSpec.Field(i))
+		}
+	}
+
+	s.AiModels = models
+	s.Extends = extends
+	s.Suspend = suspend
+}
+
+// MergeModels returns the base models followed by the own models. Own models replace base models
+// with the same public name in place.
+func MergeModels(base, own []AiModel) []AiModel {
+	var merged []AiModel
+	overridden := make(map[string]bool)
+	for _, model := range base {
+		replaced := false
+		for _, override := range own {
+			if override.PublicName() == model.PublicName() {
+				merged = append(merged, *override.DeepCopy())
+				overridden[override.PublicName()] = true
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, *model.DeepCopy())
+		}
+	}
+	for _, model := range own {
+		if !overridden[model.PublicName()] {
+			merged = append(merged, *model.DeepCopy())
+		}
+	}
+	return merged
+}
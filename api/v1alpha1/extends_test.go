@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInheritFrom(t *testing.T) {
+	base := &AiGatewaySpec{
+		Port: 4000,
+		AiModels: []AiModel{
+			{Name: "gpt-4o", Provider: "openai"},
+			{Name: "claude-3-5-sonnet", Provider: "anthropic", Alias: "claude"},
+		},
+		Logging: &LoggingConfig{Level: LogLevelInfo},
+		Caching: &CachingConfig{Enabled: true},
+		Suspend: true,
+	}
+	spec := &AiGatewaySpec{
+		AiModels: []AiModel{
+			{Name: "claude-3-7-sonnet", Provider: "anthropic", Alias: "claude"},
+			{Name: "mistral-large", Provider: "mistral"},
+		},
+		Logging: &LoggingConfig{Level: LogLevelDebug},
+		Extends: &ExtendsRef{Kind: ExtendsKindAiGateway, Name: "platform"},
+	}
+
+	spec.InheritFrom(base)
+
+	if spec.Port != 4000 {
+		t.Errorf("Port = %d, expected it to be inherited", spec.Port)
+	}
+	if spec.Logging.Level != LogLevelDebug {
+		t.Errorf("Logging.Level = %q, expected the own setting to be kept", spec.Logging.Level)
+	}
+	if spec.Caching == nil || spec.Caching == base.Caching {
+		t.Errorf("Caching = %v, expected a copy of the base setting", spec.Caching)
+	}
+	if spec.Extends == nil || spec.Extends.Name != "platform" {
+		t.Errorf("Extends = %v, expected the own reference to be kept", spec.Extends)
+	}
+	if spec.Suspend {
+		t.Error("Suspend = true, expected a suspended base not to suspend the gateway")
+	}
+
+	var names []string
+	for _, model := range spec.AiModels {
+		names = append(names, model.Name)
+	}
+	expected := []string{"gpt-4o", "claude-3-7-sonnet", "mistral-large"}
+	if !slices.Equal(names, expected) {
+		t.Errorf("AiModels = %v, expected %v", names, expected)
+	}
+}
+
+func TestExtendableFrom(t *testing.T) {
+	tests := []struct {
+		annotation string
+		namespace  string
+		expected   bool
+	}{
+		{"", "platform", true},
+		{"", "team-a", false},
+		{"team-a, team-b", "team-b", true},
+		{"team-a,team-b", "team-c", false},
+		{"*", "team-c", true},
+	}
+	for _, tt := range tests {
+		g := &AiGateway{}
+		g.Namespace = "platform"
+		if tt.annotation != "" {
+			g.Annotations = map[string]string{ExtendableFromAnnotation: tt.annotation}
+		}
+		if got := g.ExtendableFrom(tt.namespace); got != tt.expected {
+			t.Errorf("ExtendableFrom(%q) with annotation %q = %v, expected %v", tt.namespace, tt.annotation, got,
+				tt.expected)
+		}
+	}
+}
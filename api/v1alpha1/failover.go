@@ -0,0 +1,39 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// FailoverDeployments returns the deployments implementations generate for the model, in the order requests fail
+// over between them: the primary endpoint, followed by a copy of the model per failover endpoint that reaches it
+// at the endpoint's API base instead. ModelStatus.ActiveEndpoint reports the first as PrimaryEndpointName and
+// the others by the name of their failover endpoint.
+func (m AiModel) FailoverDeployments() []AiModel {
+	primary := *m.DeepCopy()
+	primary.FailoverEndpoints = nil
+	deployments := []AiModel{primary}
+
+	for _, endpoint := range m.FailoverEndpoints {
+		deployment := *primary.DeepCopy()
+		deployment.BackendRef = nil
+		if deployment.Azure != nil {
+			deployment.Azure.APIBase = endpoint.APIBase
+		} else {
+			deployment.APIBase = endpoint.APIBase
+		}
+		deployments = append(deployments, deployment)
+	}
+	return deployments
+}
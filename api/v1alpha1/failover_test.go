@@ -0,0 +1,82 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFailoverDeployments(t *testing.T) {
+	tests := []struct {
+		name  string
+		model AiModel
+		want  []AiModel
+	}{
+		{
+			name:  "no failover endpoints",
+			model: AiModel{Name: "gpt-4o", Provider: "openai"},
+			want:  []AiModel{{Name: "gpt-4o", Provider: "openai"}},
+		},
+		{
+			name: "azure regions",
+			model: AiModel{
+				Name:     "gpt-4o",
+				Provider: "azure",
+				Azure:    &AzureConfig{APIBase: "https://eu.openai.azure.com", APIVersion: "2024-06-01"},
+				FailoverEndpoints: []FailoverEndpoint{
+					{Name: "us", APIBase: "https://us.openai.azure.com"},
+				},
+			},
+			want: []AiModel{
+				{
+					Name:     "gpt-4o",
+					Provider: "azure",
+					Azure:    &AzureConfig{APIBase: "https://eu.openai.azure.com", APIVersion: "2024-06-01"},
+				},
+				{
+					Name:     "gpt-4o",
+					Provider: "azure",
+					Azure:    &AzureConfig{APIBase: "https://us.openai.azure.com", APIVersion: "2024-06-01"},
+				},
+			},
+		},
+		{
+			name: "in-cluster backend with external fallback",
+			model: AiModel{
+				Name:       "llama3",
+				Provider:   "ollama",
+				BackendRef: &BackendServiceRef{Name: "ollama"},
+				FailoverEndpoints: []FailoverEndpoint{
+					{Name: "hosted", APIBase: "https://llm.example.com/v1"},
+				},
+			},
+			want: []AiModel{
+				{Name: "llama3", Provider: "ollama", BackendRef: &BackendServiceRef{Name: "ollama"}},
+				{Name: "llama3", Provider: "ollama", APIBase: "https://llm.example.com/v1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.model.FailoverDeployments(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FailoverDeployments() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
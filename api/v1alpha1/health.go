@@ -0,0 +1,113 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PodsHealthyReason is the reason of the Degraded condition if no gateway pod fails.
+const PodsHealthyReason = "PodsHealthy"
+
+// NoServingEndpointsReason is the reason of the Ready condition while the gateway Service has no ready endpoints.
+const NoServingEndpointsReason = "NoServingEndpoints"
+
+// podFailureReasons are the reasons of waiting or terminated containers that indicate a failing gateway pod.
+// They are used as reasons of the Degraded condition.
+var podFailureReasons = []string{
+	"ImagePullBackOff",
+	"ErrImagePull",
+	"InvalidImageName",
+	"CreateContainerConfigError",
+	"CreateContainerError",
+	"CrashLoopBackOff",
+	"OOMKilled",
+}
+
+// DegradedCondition returns the Degraded condition for the pods of a gateway. Implementations watch the pods
+// of the gateway Deployment and set it with the generation of the AiGateway, so users find pod errors such as
+// ImagePullBackOff, CrashLoopBackOff or OOMKilled in the AiGateway status instead of inspecting the pods.
+func DegradedCondition(pods []corev1.Pod, observedGeneration int64) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               AiGatewayConditionDegraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             PodsHealthyReason,
+		Message:            "No gateway pod is failing",
+		ObservedGeneration: observedGeneration,
+	}
+	if reason, message := PodFailure(pods); reason != "" {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reason
+		condition.Message = message
+	}
+	return condition
+}
+
+// PodFailure returns the reason and message of the first failing container of the pods, or empty strings if no
+// container fails. A container that was OOMKilled and is restarting again is reported as OOMKilled rather than
+// CrashLoopBackOff, because the reason for the restarts is more helpful.
+func PodFailure(pods []corev1.Pod) (reason, message string) {
+	for _, pod := range pods {
+		statuses := slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses)
+		for _, status := range statuses {
+			if reason, message := containerFailure(status); reason != "" {
+				return reason, fmt.Sprintf("container %s of pod %s: %s", status.Name, pod.Name, message)
+			}
+		}
+	}
+	return "", ""
+}
+
+// containerFailure returns the reason and message of a failing container.
+func containerFailure(status corev1.ContainerStatus) (reason, message string) {
+	if terminated := status.LastTerminationState.Terminated; terminated != nil && terminated.Reason == "OOMKilled" &&
+		status.State.Running == nil {
+		return terminated.Reason, fmt.Sprintf("terminated with exit code %d after exceeding its memory limit, "+
+			"restarted %d times", terminated.ExitCode, status.RestartCount)
+	}
+	if terminated := status.State.Terminated; terminated != nil && slices.Contains(podFailureReasons, terminated.Reason) {
+		return terminated.Reason, fmt.Sprintf("terminated with exit code %d", terminated.ExitCode)
+	}
+	if waiting := status.State.Waiting; waiting != nil && slices.Contains(podFailureReasons, waiting.Reason) {
+		if waiting.Message == "" {
+			return waiting.Reason, "waiting with reason " + waiting.Reason
+		}
+		return waiting.Reason, waiting.Message
+	}
+	return "", ""
+}
+
+// HasServingEndpoints reports whether the EndpointSlices of the gateway Service, i.e. the slices labeled with
+// discoveryv1.LabelServiceName, contain a ready endpoint. Implementations watch these EndpointSlices and only set
+// the Ready condition and status.url once the Service has a ready endpoint, so that clients do not discover a URL
+// that fails during the initial rollout. Until then, the Ready condition is False with NoServingEndpointsReason.
+func HasServingEndpoints(endpointSlices []discoveryv1.EndpointSlice) bool {
+	for _, slice := range endpointSlices {
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition is to be interpreted as ready.
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDegradedCondition(t *testing.T) {
+	pod := func(name string, status corev1.ContainerStatus) corev1.Pod {
+		status.Name = "router"
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{status}},
+		}
+	}
+	running := corev1.ContainerStatus{State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}
+	pullBackOff := corev1.ContainerStatus{State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+		Reason:  "ImagePullBackOff",
+		Message: `Back-off pulling image "litellm:missing"`,
+	}}}
+	oomKilled := corev1.ContainerStatus{
+		RestartCount: 3,
+		State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		LastTerminationState: corev1.ContainerState{
+			Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137},
+		},
+	}
+
+	tests := map[string]struct {
+		pods    []corev1.Pod
+		status  metav1.ConditionStatus
+		reason  string
+		message string
+	}{
+		"healthy": {
+			pods:    []corev1.Pod{pod("gateway-a", running)},
+			status:  metav1.ConditionFalse,
+			reason:  PodsHealthyReason,
+			message: "No gateway pod is failing",
+		},
+		"image pull": {
+			pods:    []corev1.Pod{pod("gateway-a", running), pod("gateway-b", pullBackOff)},
+			status:  metav1.ConditionTrue,
+			reason:  "ImagePullBackOff",
+			message: `container router of pod gateway-b: Back-off pulling image "litellm:missing"`,
+		},
+		"out of memory": {
+			pods:   []corev1.Pod{pod("gateway-a", oomKilled)},
+			status: metav1.ConditionTrue,
+			reason: "OOMKilled",
+			message: "container router of pod gateway-a: terminated with exit code 137 after exceeding its " +
+				"memory limit, restarted 3 times",
+		},
+	}
+	for name, test := range tests {
+		condition := DegradedCondition(test.pods, 2)
+		if condition.Type != AiGatewayConditionDegraded || condition.ObservedGeneration != 2 {
+			t.Errorf("%s: unexpected condition %+v", name, condition)
+		}
+		if condition.Status != test.status || condition.Reason != test.reason || condition.Message != test.message {
+			t.Errorf("%s: DegradedCondition() = %s/%s/%q, expected %s/%s/%q", name, condition.Status,
+				condition.Reason, condition.Message, test.status, test.reason, test.message)
+		}
+	}
+}
+
+func TestHasServingEndpoints(t *testing.T) {
+	slice := func(ready *bool) []discoveryv1.EndpointSlice {
+		return []discoveryv1.EndpointSlice{{Endpoints: []discoveryv1.Endpoint{{
+			Addresses:  []string{"10.0.0.1"},
+			Conditions: discoveryv1.EndpointConditions{Ready: ready},
+		}}}}
+	}
+	notReady, ready := false, true
+
+	tests := map[string]struct {
+		slices   []discoveryv1.EndpointSlice
+		expected bool
+	}{
+		"no slices":        {expected: false},
+		"empty slice":      {slices: []discoveryv1.EndpointSlice{{}}, expected: false},
+		"not ready":        {slices: slice(&notReady), expected: false},
+		"ready":            {slices: slice(&ready), expected: true},
+		"unknown is ready": {slices: slice(nil), expected: true},
+	}
+	for name, test := range tests {
+		if got := HasServingEndpoints(test.slices); got != test.expected {
+			t.Errorf("%s: HasServingEndpoints() = %t, expected %t", name, got, test.expected)
+		}
+	}
+}
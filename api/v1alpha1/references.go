@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SecretReferenceIndexField is the field index implementations register for AiGateways, keyed by
+// ReferencedSecrets. A watch on Secrets maps a changed Secret to the AiGateways referencing it through
+// this index, so that credential rotation re-renders the configuration and rolls the gateway pods.
+const SecretReferenceIndexField = ".spec.secretReferences"
+
+// ReferencedSecrets returns the sorted, unique names of all Secrets in its namespace the AiGateway references.
+// Implementations include the data of these Secrets in the ConfigChecksum of the gateway pods, together with
+// the ReferencedModelSecrets of the models the gateway imports from AiModelLibraries.
+func (g *AiGateway) ReferencedSecrets() []string {
+	spec := &g.Spec
+	var names []string
+	addName := func(ref *corev1.LocalObjectReference) {
+		if ref != nil && ref.Name != "" {
+			names = append(names, ref.Name)
+		}
+	}
+	addRef := func(ref *corev1.SecretKeySelector) {
+		if ref != nil {
+			addName(&ref.LocalObjectReference)
+		}
+	}
+	addSource := func(source *ContentSource) {
+		if source != nil {
+			addRef(source.SecretKeyRef)
+		}
+	}
+	addPromptPolicy := func(policy *PromptPolicy) {
+		if policy != nil && policy.SystemPrefix != nil {
+			addSource(&policy.SystemPrefix.ContentSource)
+		}
+	}
+
+	addSource(spec.ImportConfigRef)
+	addPromptPolicy(spec.PromptPolicy)
+	if spec.Database != nil {
+		addRef(&spec.Database.SecretRef)
+	}
+	addRef(spec.RedisRef)
+	addRef(spec.MasterKeySecretRef)
+	if ref := spec.SecretProviderClassRef; ref != nil && ref.SyncedSecretName != "" {
+		names = append(names, ref.SyncedSecretName)
+	}
+	if spec.Auth != nil && spec.Auth.JWT != nil {
+		addRef(spec.Auth.JWT.JWKSSecretRef)
+	}
+	if spec.Egress != nil {
+		addSource(spec.Egress.CABundleRef)
+	}
+	if spec.Audit != nil {
+		addName(spec.Audit.CredentialsSecretRef)
+	}
+	for _, server := range spec.MCPServers {
+		addRef(server.AuthorizationSecretRef)
+	}
+	if spec.Hooks != nil {
+		for _, hook := range []*HookEndpoint{spec.Hooks.PreCall, spec.Hooks.PostCall} {
+			if hook != nil {
+				addRef(hook.AuthorizationSecretRef)
+			}
+		}
+	}
+	if spec.Observability != nil {
+		for _, callback := range spec.Observability.Callbacks {
+			if callback.Langfuse != nil {
+				addRef(&callback.Langfuse.PublicKeySecretRef)
+				addRef(&callback.Langfuse.SecretKeySecretRef)
+			}
+			if callback.Helicone != nil {
+				addRef(&callback.Helicone.APIKeySecretRef)
+			}
+			if callback.Webhook != nil {
+				addRef(callback.Webhook.AuthorizationSecretRef)
+			}
+		}
+	}
+	names = append(names, ReferencedModelSecrets(spec.AiModels)...)
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// ReferencedModelSecrets returns the sorted, unique names of all Secrets the models reference.
+func ReferencedModelSecrets(models []AiModel) []string {
+	var names []string
+	addRef := func(ref *corev1.SecretKeySelector) {
+		if ref != nil && ref.Name != "" {
+			names = append(names, ref.Name)
+		}
+	}
+
+	for _, model := range models {
+		addRef(model.APIKeySecretRef)
+		if model.PromptPolicy != nil && model.PromptPolicy.SystemPrefix != nil {
+			addRef(model.PromptPolicy.SystemPrefix.SecretKeyRef)
+		}
+		if model.VertexAI != nil {
+			addRef(model.VertexAI.CredentialsSecretRef)
+		}
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
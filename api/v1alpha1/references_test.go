@@ -0,0 +1,53 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func secretKeyRef(name string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: "key"}
+}
+
+func TestReferencedSecrets(t *testing.T) {
+	gateway := &AiGateway{Spec: AiGatewaySpec{
+		Database:               &DatabaseConfig{SecretRef: *secretKeyRef("db")},
+		RedisRef:               secretKeyRef("redis"),
+		MasterKeySecretRef:     secretKeyRef("db"),
+		SecretProviderClassRef: &SecretProviderClassRef{Name: "vault", SyncedSecretName: "provider-keys"},
+		Hooks:                  &HooksConfig{PostCall: &HookEndpoint{AuthorizationSecretRef: secretKeyRef("hooks")}},
+		Egress: &EgressConfig{CABundleRef: &ContentSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "ca"}, Key: "ca.crt",
+		}}},
+		AiModels: []AiModel{
+			{Name: "gemini-pro", Provider: "vertex_ai", VertexAI: &VertexAIConfig{CredentialsSecretRef: secretKeyRef("gcp")}},
+			{Name: "gpt-4o", Provider: "openai", APIKeySecretRef: secretKeyRef("openai")},
+			{Name: "gpt-4", Provider: "openai", PromptPolicy: &PromptPolicy{SystemPrefix: &SystemPromptInjection{
+				ContentSource: ContentSource{SecretKeyRef: secretKeyRef("prompt")},
+			}}},
+		},
+	}}
+
+	expected := []string{"db", "gcp", "hooks", "openai", "prompt", "provider-keys", "redis"}
+	if got := gateway.ReferencedSecrets(); !slices.Equal(got, expected) {
+		t.Errorf("ReferencedSecrets() = %v, expected %v", got, expected)
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxCronSearch bounds the search for the next start of a window, e.g. for "0 0 29 2 *" in a year without
+// February 29th.
+const maxCronSearch = 5 * 366 * 24 * time.Hour
+
+// cronField is the set of values of a cron field, one bit per value.
+type cronField uint64
+
+// cronSchedule is a parsed cron expression with the fields minute, hour, day of month, month and day of week.
+type cronSchedule struct {
+	minute, hour, dayOfMonth, month, dayOfWeek cronField
+	// anyDayOfMonth and anyDayOfWeek are set for "*": a day matches if both fields match. If both are
+	// restricted, a day matches if either does, as in crontab(5).
+	anyDayOfMonth, anyDayOfWeek bool
+}
+
+// ParseCron validates a cron expression as used by ScalingSchedule.Start.
+func ParseCron(expr string) error {
+	_, err := parseCron(expr)
+	return err
+}
+
+func parseCron(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute, hour, day of month, month, day of week), got %d",
+			len(fields))
+	}
+	var schedule cronSchedule
+	var err error
+	for i, spec := range []struct {
+		field    *cronField
+		name     string
+		min, max int
+	}{
+		{&schedule.minute, "minute", 0, 59},
+		{&schedule.hour, "hour", 0, 23},
+		{&schedule.dayOfMonth, "day of month", 1, 31},
+		{&schedule.month, "month", 1, 12},
+		{&schedule.dayOfWeek, "day of week", 0, 7},
+	} {
+		if *spec.field, err = parseCronField(fields[i], spec.min, spec.max); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", spec.name, fields[i], err)
+		}
+	}
+	// Sunday is 0 or 7.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+	schedule.anyDayOfMonth = fields[2] == "*"
+	schedule.anyDayOfWeek = fields[4] == "*"
+	return &schedule, nil
+}
+
+// parseCronField parses a comma-separated list of "*", values and ranges, each optionally with a "/step".
+func parseCronField(field string, minValue, maxValue int) (cronField, error) {
+	var values cronField
+	for _, item := range strings.Split(field, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, fmt.Errorf("step must be a positive number")
+			}
+		}
+
+		low, high := minValue, maxValue
+		if rangeExpr != "*" {
+			lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = strconv.Atoi(lowExpr); err != nil {
+				return 0, fmt.Errorf("%q is not a number", lowExpr)
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highExpr); err != nil {
+					return 0, fmt.Errorf("%q is not a number", highExpr)
+				}
+			} else if hasStep {
+				high = maxValue
+			}
+			if low < minValue || high > maxValue || low > high {
+				return 0, fmt.Errorf("values must be between %d and %d", minValue, maxValue)
+			}
+		}
+		for value := low; value <= high; value += step {
+			values |= 1 << value
+		}
+	}
+	return values, nil
+}
+
+func (f cronField) has(value int) bool {
+	return f&(1<<value) != 0
+}
+
+func (s *cronSchedule) matchesDay(t time.Time) bool {
+	dayOfMonth, dayOfWeek := s.dayOfMonth.has(t.Day()), s.dayOfWeek.has(int(t.Weekday()))
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// next returns the first time after t matching the schedule, or the zero time if there is none within
+// maxCronSearch.
+func (s *cronSchedule) next(t time.Time) time.Time {
+	end := t.Add(maxCronSearch)
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	for t.Before(end) {
+		switch {
+		case !s.month.has(int(t.Month())):
+			t = advance(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location()))
+		case !s.matchesDay(t):
+			t = advance(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location()))
+		case !s.hour.has(t.Hour()):
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+		case !s.minute.has(t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// advance returns the start of the next month or day, unless it doesn't exist because of a daylight saving time
+// gap and time.Date normalized it to t or earlier. The next minute is returned then, so that next always makes
+// progress.
+func advance(t, start time.Time) time.Time {
+	if start.After(t) {
+		return start
+	}
+	return t.Add(time.Minute)
+}
+
+// window returns whether the window of the schedule is open at now and the time the window next opens or
+// closes.
+func (s *ScalingSchedule) window(now time.Time) (bool, time.Time, error) {
+	start, err := parseCron(s.Start)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf("schedule %q: %w", s.Name, err)
+	}
+	location := time.UTC
+	if s.TimeZone != "" {
+		if location, err = time.LoadLocation(s.TimeZone); err != nil {
+			return false, time.Time{}, fmt.Errorf("schedule %q: %w", s.Name, err)
+		}
+	}
+	now = now.In(location)
+
+	// The window is open if it started within the last Duration.
+	if opened := start.next(now.Add(-s.Duration.Duration)); !opened.IsZero() && !opened.After(now) {
+		return true, opened.Add(s.Duration.Duration), nil
+	}
+	return false, start.next(now), nil
+}
+
+// ScheduledReplicas returns the replicas of the first schedule whose window is open at now, or nil if none is,
+// and the time of the next window boundary of any schedule, at which the operator updates
+// status.scheduledReplicas again. The boundary is zero if no schedule opens again.
+func (s *AiGatewaySpec) ScheduledReplicas(now time.Time) (*int32, time.Time, error) {
+	var replicas *int32
+	var boundary time.Time
+	for i := range s.Schedules {
+		schedule := &s.Schedules[i]
+		open, next, err := schedule.window(now)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if open && replicas == nil {
+			replicas = new(int32)
+			*replicas = schedule.Replicas
+		}
+		if !next.IsZero() && (boundary.IsZero() || next.Before(boundary)) {
+			boundary = next
+		}
+	}
+	return replicas, boundary, nil
+}
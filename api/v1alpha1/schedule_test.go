@@ -0,0 +1,166 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestScheduledReplicas(t *testing.T) {
+	spec := &AiGatewaySpec{Schedules: []ScalingSchedule{
+		{
+			Name:     "business-hours",
+			Start:    "0 8 * * 1-5",
+			Duration: metav1.Duration{Duration: 10 * time.Hour},
+			Replicas: 3,
+			TimeZone: "Europe/Berlin",
+		},
+		{
+			Name:     "nightly-batch",
+			Start:    "30 17 * * *",
+			Duration: metav1.Duration{Duration: 3 * time.Hour},
+			Replicas: 5,
+			TimeZone: "Europe/Berlin",
+		},
+	}}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(day, hour, minute int) time.Time {
+		// June 2nd, 2025 is a Monday.
+		return time.Date(2025, time.June, day, hour, minute, 0, 0, berlin)
+	}
+
+	tests := map[string]struct {
+		now      time.Time
+		replicas *int32
+		boundary time.Time
+	}{
+		"monday morning before business hours": {
+			now:      at(2, 7, 15),
+			boundary: at(2, 8, 0),
+		},
+		"monday business hours": {
+			now:      at(2, 9, 0),
+			replicas: int32Ptr(3),
+			boundary: at(2, 17, 30),
+		},
+		"overlap takes the first schedule": {
+			now:      at(2, 17, 45),
+			replicas: int32Ptr(3),
+			boundary: at(2, 18, 0),
+		},
+		"monday evening": {
+			now:      at(2, 19, 0),
+			replicas: int32Ptr(5),
+			boundary: at(2, 20, 30),
+		},
+		"saturday": {
+			now:      at(7, 10, 0),
+			boundary: at(7, 17, 30),
+		},
+	}
+	if got := spec.MaxReplicas(); got != 5 {
+		t.Errorf("MaxReplicas() = %d, want the replicas of the largest schedule", got)
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			replicas, boundary, err := spec.ScheduledReplicas(tt.now)
+			if err != nil {
+				t.Fatalf("ScheduledReplicas() error = %v", err)
+			}
+			if (replicas == nil) != (tt.replicas == nil) || (replicas != nil && *replicas != *tt.replicas) {
+				t.Errorf("ScheduledReplicas() replicas = %v, want %v", replicas, tt.replicas)
+			}
+			if !boundary.Equal(tt.boundary) {
+				t.Errorf("ScheduledReplicas() boundary = %v, want %v", boundary, tt.boundary)
+			}
+		})
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	tests := map[string]string{
+		"*/15 8-18 1,15 * 0":   "",
+		"0 8 * * 7":            "",
+		"0 8 * *":              "expected 5 fields",
+		"60 * * * *":           `invalid minute "60": values must be between 0 and 59`,
+		"0 8 * * MON":          `invalid day of week "MON": "MON" is not a number`,
+		"0 18-8 * * *":         `invalid hour "18-8"`,
+		"*/0 * * * *":          "step must be a positive number",
+		"0 0 29 2 *":           "",
+		"0 0 31 4 *":           "",
+		"0 12 * 1-3,10-12 1-5": "",
+	}
+	for expr, want := range tests {
+		t.Run(expr, func(t *testing.T) {
+			err := ParseCron(expr)
+			if want == "" && err != nil {
+				t.Errorf("ParseCron(%q) error = %v", expr, err)
+			}
+			if want != "" && (err == nil || !strings.Contains(err.Error(), want)) {
+				t.Errorf("ParseCron(%q) error = %v, want %q", expr, err, want)
+			}
+		})
+	}
+}
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+func TestScheduledReplicasAcrossDaylightSavingTime(t *testing.T) {
+	spec := &AiGatewaySpec{Schedules: []ScalingSchedule{{
+		Name:     "morning",
+		Start:    "0 5 * * *",
+		Duration: metav1.Duration{Duration: time.Hour},
+		Replicas: 3,
+		TimeZone: "America/New_York",
+	}}}
+
+	// 00:30 EST on the day clocks skip from 02:00 EST to 03:00 EDT.
+	now := time.Date(2026, 3, 8, 5, 30, 0, 0, time.UTC)
+	done := make(chan struct{})
+	var replicas *int32
+	var boundary time.Time
+	var err error
+	go func() {
+		defer close(done)
+		replicas, boundary, err = spec.ScheduledReplicas(now)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("ScheduledReplicas() did not return across the daylight saving time gap")
+	}
+
+	if err != nil {
+		t.Fatalf("ScheduledReplicas() error = %v", err)
+	}
+	if replicas != nil {
+		t.Errorf("ScheduledReplicas() replicas = %d, want nil", *replicas)
+	}
+	// 05:00 EDT.
+	if want := time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC); !boundary.Equal(want) {
+		t.Errorf("ScheduledReplicas() boundary = %v, want %v", boundary, want)
+	}
+}
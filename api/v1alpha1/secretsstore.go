@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// SecretsStoreCSIDriver is the name of the Secrets Store CSI driver.
+	SecretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+	// SecretsStoreVolumeName is the name of the volume mounting the SecretProviderClass in the gateway pods.
+	SecretsStoreVolumeName = "secrets-store"
+	// DefaultSecretsStoreMountPath is the default of SecretProviderClassRef.MountPath.
+	DefaultSecretsStoreMountPath = "/mnt/secrets-store"
+)
+
+// SecretsStoreVolume returns the CSI volume of the SecretProviderClass referenced by spec.secretProviderClassRef
+// and its mount in the gateway container, or nils if none is referenced. Implementations add both to the gateway
+// pods; the volume must be mounted for the CSI driver to fetch the secrets and to sync them to SyncedSecretName.
+func (s *AiGatewaySpec) SecretsStoreVolume() (*corev1.Volume, *corev1.VolumeMount) {
+	ref := s.SecretProviderClassRef
+	if ref == nil {
+		return nil, nil
+	}
+	mountPath := ref.MountPath
+	if mountPath == "" {
+		mountPath = DefaultSecretsStoreMountPath
+	}
+	volume := &corev1.Volume{
+		Name: SecretsStoreVolumeName,
+		VolumeSource: corev1.VolumeSource{CSI: &corev1.CSIVolumeSource{
+			Driver:           SecretsStoreCSIDriver,
+			ReadOnly:         ptr.To(true),
+			VolumeAttributes: map[string]string{"secretProviderClass": ref.Name},
+		}},
+	}
+	return volume, &corev1.VolumeMount{Name: SecretsStoreVolumeName, MountPath: mountPath, ReadOnly: true}
+}
+
+// SecretsStoreEnvFrom returns the envFrom source of the gateway container loading the Secret the
+// SecretProviderClass syncs to, or nil if spec.secretProviderClassRef does not set a SyncedSecretName.
+func (s *AiGatewaySpec) SecretsStoreEnvFrom() *corev1.EnvFromSource {
+	ref := s.SecretProviderClassRef
+	if ref == nil || ref.SyncedSecretName == "" {
+		return nil
+	}
+	return &corev1.EnvFromSource{SecretRef: &corev1.SecretEnvSource{
+		LocalObjectReference: corev1.LocalObjectReference{Name: ref.SyncedSecretName},
+	}}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestSecretsStoreVolume(t *testing.T) {
+	spec := &AiGatewaySpec{}
+	if volume, mount := spec.SecretsStoreVolume(); volume != nil || mount != nil {
+		t.Errorf("SecretsStoreVolume() = %v, %v without a SecretProviderClass, want nil", volume, mount)
+	}
+	if envFrom := spec.SecretsStoreEnvFrom(); envFrom != nil {
+		t.Errorf("SecretsStoreEnvFrom() = %v without a SecretProviderClass, want nil", envFrom)
+	}
+
+	spec.SecretProviderClassRef = &SecretProviderClassRef{Name: "vault", SyncedSecretName: "provider-keys"}
+	volume, mount := spec.SecretsStoreVolume()
+	if volume.CSI == nil || volume.CSI.Driver != SecretsStoreCSIDriver ||
+		volume.CSI.VolumeAttributes["secretProviderClass"] != "vault" {
+		t.Errorf("SecretsStoreVolume() = %+v, want a CSI volume of SecretProviderClass vault", volume)
+	}
+	if mount.Name != volume.Name || mount.MountPath != DefaultSecretsStoreMountPath || !mount.ReadOnly {
+		t.Errorf("SecretsStoreVolume() mount = %+v, want a read-only mount at %s", mount, DefaultSecretsStoreMountPath)
+	}
+	if envFrom := spec.SecretsStoreEnvFrom(); envFrom == nil || envFrom.SecretRef.Name != "provider-keys" {
+		t.Errorf("SecretsStoreEnvFrom() = %v, want the Secret provider-keys", envFrom)
+	}
+}
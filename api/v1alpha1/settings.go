@@ -0,0 +1,68 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Keys of the ConfigMap referenced by SettingsFrom. They match the sections of the LiteLLM configuration.
+const (
+	SettingsKeyLiteLLM = "litellm_settings"
+	SettingsKeyRouter  = "router_settings"
+)
+
+// ParseSettings parses the data of the ConfigMap referenced by SettingsFrom into the settings by section.
+// It fails on keys other than the SettingsKey constants and on values that are not YAML mappings.
+func ParseSettings(data map[string]string) (map[string]map[string]any, error) {
+	settings := make(map[string]map[string]any, len(data))
+	for key, value := range data {
+		if key != SettingsKeyLiteLLM && key != SettingsKeyRouter {
+			return nil, fmt.Errorf("unsupported key %q, supported keys are %s and %s",
+				key, SettingsKeyLiteLLM, SettingsKeyRouter)
+		}
+		var section map[string]any
+		if err := yaml.Unmarshal([]byte(value), &section); err != nil {
+			return nil, fmt.Errorf("%s must be a YAML mapping: %w", key, err)
+		}
+		settings[key] = section
+	}
+	return settings, nil
+}
+
+// MergeSettings merges the data of the ConfigMap referenced by SettingsFrom into the generated LiteLLM
+// configuration. Settings of the ConfigMap replace generated settings with the same name; nested mappings are
+// replaced as a whole.
+func MergeSettings(config map[string]any, data map[string]string) error {
+	settings, err := ParseSettings(data)
+	if err != nil {
+		return err
+	}
+	for key, section := range settings {
+		merged, ok := config[key].(map[string]any)
+		if !ok {
+			merged = make(map[string]any, len(section))
+		}
+		for name, value := range section {
+			merged[name] = value
+		}
+		config[key] = merged
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeSettings(t *testing.T) {
+	config := map[string]any{
+		SettingsKeyLiteLLM: map[string]any{"drop_params": false, "request_timeout": float64(600)},
+		"model_list":       []any{},
+	}
+	data := map[string]string{
+		SettingsKeyLiteLLM: "drop_params: true\nsuccess_callback: [langfuse]\n",
+		SettingsKeyRouter:  "routing_strategy: least-busy\n",
+	}
+
+	if err := MergeSettings(config, data); err != nil {
+		t.Fatalf("MergeSettings() error = %v", err)
+	}
+	want := map[string]any{
+		SettingsKeyLiteLLM: map[string]any{
+			"drop_params":      true,
+			"request_timeout":  float64(600),
+			"success_callback": []any{"langfuse"},
+		},
+		SettingsKeyRouter: map[string]any{"routing_strategy": "least-busy"},
+		"model_list":      []any{},
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("MergeSettings() = %v, want %v", config, want)
+	}
+}
+
+func TestParseSettingsErrors(t *testing.T) {
+	tests := map[string]struct {
+		data map[string]string
+		err  string
+	}{
+		"unsupported key": {
+			data: map[string]string{"model_list": "[]"},
+			err:  `unsupported key "model_list"`,
+		},
+		"invalid YAML": {
+			data: map[string]string{SettingsKeyRouter: "routing_strategy: [least-busy"},
+			err:  "router_settings must be a YAML mapping",
+		},
+		"not a mapping": {
+			data: map[string]string{SettingsKeyLiteLLM: "- drop_params"},
+			err:  "litellm_settings must be a YAML mapping",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSettings(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ParseSettings() error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// ShardLabel assigns an AiGateway or ClusterAiGateway to a shard. Implementations running several operator
+// replicas, each with its own --shard and leader election, only reconcile the gateways of their shard, so that
+// large fleets are not reconciled by a single leader. Gateways without the label are in the default shard "".
+const ShardLabel = "agentic-layer.ai/shard"
+
+// ShardSelector returns the label selector of the gateways of a shard, to list or cache only those. The selector
+// of the default shard "" matches the gateways without the ShardLabel.
+func ShardSelector(shard string) labels.Selector {
+	operator, values := selection.Equals, []string{shard}
+	if shard == "" {
+		operator, values = selection.DoesNotExist, nil
+	}
+	requirement, err := labels.NewRequirement(ShardLabel, operator, values)
+	if err != nil {
+		// Only happens for shards that are not valid label values, which implementations reject on start.
+		return labels.Nothing()
+	}
+	return labels.NewSelector().Add(*requirement)
+}
+
+// InShard reports whether the gateway with the given labels belongs to the shard.
+func InShard(gatewayLabels map[string]string, shard string) bool {
+	return ShardSelector(shard).Matches(labels.Set(gatewayLabels))
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestInShard(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		shard  string
+		want   bool
+	}{
+		{name: "default shard without label", shard: "", want: true},
+		{name: "default shard with other labels", labels: map[string]string{"team": "a"}, shard: "", want: true},
+		{name: "default shard with label", labels: map[string]string{ShardLabel: "a"}, shard: "", want: false},
+		{name: "same shard", labels: map[string]string{ShardLabel: "a"}, shard: "a", want: true},
+		{name: "other shard", labels: map[string]string{ShardLabel: "b"}, shard: "a", want: false},
+		{name: "shard without label", shard: "a", want: false},
+		{name: "invalid shard", labels: map[string]string{ShardLabel: "a b"}, shard: "a b", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := InShard(tt.labels, tt.shard); got != tt.want {
+				t.Errorf("InShard(%v, %q) = %v, want %v", tt.labels, tt.shard, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// Keys of the templates ConfigMap referenced by AiGatewayClassSpec.TemplatesRef. Each key holds the template
+// of the resource of the same kind; implementations create their own resource for keys that are not set.
+const (
+	// TemplateKeyDeployment holds the template of the gateway Deployment.
+	TemplateKeyDeployment = "deployment.yaml"
+	// TemplateKeyService holds the template of the gateway Service.
+	TemplateKeyService = "service.yaml"
+	// TemplateKeyConfigMap holds the template of the gateway configuration ConfigMap.
+	TemplateKeyConfigMap = "configmap.yaml"
+)
+
+// templateKinds are the kinds the templates must render, by key.
+var templateKinds = map[string]schema.GroupVersionKind{
+	TemplateKeyDeployment: {Group: "apps", Version: "v1", Kind: "Deployment"},
+	TemplateKeyService:    {Version: "v1", Kind: "Service"},
+	TemplateKeyConfigMap:  {Version: "v1", Kind: "ConfigMap"},
+}
+
+// templateFuncs are the functions available to templates in addition to the text/template builtins.
+var templateFuncs = template.FuncMap{
+	// toYaml marshals a value, e.g. .Spec.Resources, to YAML.
+	"toYaml": func(v any) (string, error) {
+		data, err := yaml.Marshal(v)
+		return strings.TrimSuffix(string(data), "\n"), err
+	},
+	// indent indents every line of s by n spaces, to embed the output of toYaml.
+	"indent": func(n int, s string) string {
+		pad := strings.Repeat(" ", n)
+		return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+	},
+}
+
+// ParseTemplates parses the data of a templates ConfigMap. It fails on keys other than the TemplateKey
+// constants and on templates with syntax errors.
+func ParseTemplates(data map[string]string) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(data))
+	for key, text := range data {
+		if _, ok := templateKinds[key]; !ok {
+			return nil, fmt.Errorf("unsupported template %q, supported templates are %s, %s and %s",
+				key, TemplateKeyDeployment, TemplateKeyService, TemplateKeyConfigMap)
+		}
+		tmpl, err := template.New(key).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %q: %w", key, err)
+		}
+		templates[key] = tmpl
+	}
+	return templates, nil
+}
+
+// RenderTemplates renders the data of a templates ConfigMap with the effective AiGateway as input, e.g.
+// {{ .Name }} or {{ .Spec.Port }}, and returns the resources by key. Each template renders a single resource of
+// the kind of its key; templates rendering only whitespace omit the resource, to create it conditionally.
+// The resources are placed in the namespace of the gateway and named after it unless the template sets a name.
+func RenderTemplates(data map[string]string, g *AiGateway) (map[string]*unstructured.Unstructured, error) {
+	templates, err := ParseTemplates(data)
+	if err != nil {
+		return nil, err
+	}
+
+	objs := make(map[string]*unstructured.Unstructured, len(templates))
+	for key, tmpl := range templates {
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, g); err != nil {
+			return nil, fmt.Errorf("failed to render template %q: %w", key, err)
+		}
+		if strings.TrimSpace(out.String()) == "" {
+			continue
+		}
+
+		doc, err := yaml.YAMLToJSON(out.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("template %q did not render valid YAML: %w", key, err)
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(doc); err != nil {
+			return nil, fmt.Errorf("template %q did not render a resource: %w", key, err)
+		}
+		if want, gvk := templateKinds[key], obj.GroupVersionKind(); gvk != want {
+			return nil, fmt.Errorf("template %q must render %s %s, got %s %s",
+				key, want.GroupVersion(), want.Kind, gvk.GroupVersion(), gvk.Kind)
+		}
+		obj.SetNamespace(g.Namespace)
+		if obj.GetName() == "" {
+			obj.SetName(g.Name)
+		}
+		objs[key] = obj
+	}
+	return objs, nil
+}
+
+// TemplateKeys returns the keys of the templates in the order implementations apply the resources: the
+// configuration before the Deployment mounting it.
+func TemplateKeys() []string {
+	return []string{TemplateKeyConfigMap, TemplateKeyDeployment, TemplateKeyService}
+}
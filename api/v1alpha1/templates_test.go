@@ -0,0 +1,115 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRenderTemplates(t *testing.T) {
+	gateway := &AiGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "team-a"},
+		Spec: AiGatewaySpec{
+			Port:     4000,
+			AiModels: []AiModel{{Name: "gpt-4o", Provider: "openai"}},
+		},
+	}
+	data := map[string]string{
+		TemplateKeyService: `apiVersion: v1
+kind: Service
+metadata:
+  namespace: ignored
+spec:
+  ports:
+  - port: {{ .Spec.Port }}
+`,
+		TemplateKeyConfigMap: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}-config
+data:
+  models.yaml: |
+{{ toYaml .Spec.AiModels | indent 4 }}
+`,
+		TemplateKeyDeployment: `{{ if gt .Spec.Port 8000 }}apiVersion: apps/v1
+kind: Deployment{{ end }}`,
+	}
+
+	objs, err := RenderTemplates(data, gateway)
+	if err != nil {
+		t.Fatalf("RenderTemplates() error = %v", err)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("RenderTemplates() rendered %d resources, want 2 without the omitted Deployment", len(objs))
+	}
+
+	service := objs[TemplateKeyService]
+	if service.GetName() != "gateway" || service.GetNamespace() != "team-a" {
+		t.Errorf("Service = %s/%s, want team-a/gateway", service.GetNamespace(), service.GetName())
+	}
+	ports, _, _ := unstructured.NestedSlice(service.Object, "spec", "ports")
+	if port := ports[0].(map[string]any)["port"]; port != int64(4000) {
+		t.Errorf("Service port = %v, want 4000", port)
+	}
+
+	configMap := objs[TemplateKeyConfigMap]
+	if configMap.GetName() != "gateway-config" {
+		t.Errorf("ConfigMap name = %s, want gateway-config", configMap.GetName())
+	}
+	models, _, _ := unstructured.NestedString(configMap.Object, "data", "models.yaml")
+	if want := "- name: gpt-4o\n  provider: openai\n"; models != want {
+		t.Errorf("ConfigMap models.yaml = %q, want %q", models, want)
+	}
+}
+
+func TestRenderTemplatesErrors(t *testing.T) {
+	gateway := &AiGateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "team-a"}}
+
+	tests := map[string]struct {
+		data map[string]string
+		err  string
+	}{
+		"unsupported key": {
+			data: map[string]string{"ingress.yaml": "kind: Ingress"},
+			err:  `unsupported template "ingress.yaml"`,
+		},
+		"syntax error": {
+			data: map[string]string{TemplateKeyService: "{{ .Name "},
+			err:  `invalid template "service.yaml"`,
+		},
+		"unknown field": {
+			data: map[string]string{TemplateKeyService: "{{ .Spec.Replicaz }}"},
+			err:  `failed to render template "service.yaml"`,
+		},
+		"wrong kind": {
+			data: map[string]string{TemplateKeyDeployment: "apiVersion: v1\nkind: Service\n"},
+			err:  `template "deployment.yaml" must render apps/v1 Deployment, got v1 Service`,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := RenderTemplates(tt.data, gateway)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("RenderTemplates() error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// EffectiveTokenLimits returns the token caps a request is subject to: for each cap, the lowest one set by any
+// of limits, e.g. those of the gateway, the requested model and the AiRateLimitPolicies of the consumer.
+// Implementations render them into the proxy configuration, so a lower level can never raise a cap.
+func EffectiveTokenLimits(limits ...*RequestTokenLimits) RequestTokenLimits {
+	var effective RequestTokenLimits
+	for _, l := range limits {
+		if l == nil {
+			continue
+		}
+		effective.MaxInputTokens = minLimit(effective.MaxInputTokens, l.MaxInputTokens)
+		effective.MaxOutputTokens = minLimit(effective.MaxOutputTokens, l.MaxOutputTokens)
+	}
+	return effective
+}
+
+// minLimit returns the lower of two optional limits.
+func minLimit(a, b *int32) *int32 {
+	if a == nil || (b != nil && *b < *a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEffectiveTokenLimits(t *testing.T) {
+	limit := func(v int32) *int32 { return &v }
+	tests := []struct {
+		name     string
+		limits   []*RequestTokenLimits
+		expected RequestTokenLimits
+	}{
+		{name: "no limits", expected: RequestTokenLimits{}},
+		{
+			name:     "gateway only",
+			limits:   []*RequestTokenLimits{{MaxInputTokens: limit(8000)}, nil},
+			expected: RequestTokenLimits{MaxInputTokens: limit(8000)},
+		},
+		{
+			name: "lowest cap wins",
+			limits: []*RequestTokenLimits{
+				{MaxInputTokens: limit(8000), MaxOutputTokens: limit(1000)},
+				{MaxInputTokens: limit(16000), MaxOutputTokens: limit(500)},
+				{MaxInputTokens: limit(4000)},
+			},
+			expected: RequestTokenLimits{MaxInputTokens: limit(4000), MaxOutputTokens: limit(500)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EffectiveTokenLimits(tt.limits...); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("EffectiveTokenLimits() = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
@@ -21,10 +21,28 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/api/autoscaling/v2"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *A2AProtocol) DeepCopyInto(out *A2AProtocol) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new A2AProtocol.
+func (in *A2AProtocol) DeepCopy() *A2AProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(A2AProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AiGateway) DeepCopyInto(out *AiGateway) {
 	*out = *in
@@ -52,12 +70,27 @@ func (in *AiGateway) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayAddress) DeepCopyInto(out *AiGatewayAddress) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayAddress.
+func (in *AiGatewayAddress) DeepCopy() *AiGatewayAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AiGatewayClass) DeepCopyInto(out *AiGatewayClass) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -114,6 +147,26 @@ func (in *AiGatewayClassList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AiGatewayClassSpec) DeepCopyInto(out *AiGatewayClassSpec) {
 	*out = *in
+	if in.ParametersRef != nil {
+		in, out := &in.ParametersRef, &out.ParametersRef
+		*out = new(ParametersReference)
+		**out = **in
+	}
+	if in.AllowedProviders != nil {
+		in, out := &in.AllowedProviders, &out.AllowedProviders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxModelsPerGateway != nil {
+		in, out := &in.MaxModelsPerGateway, &out.MaxModelsPerGateway
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TemplatesRef != nil {
+		in, out := &in.TemplatesRef, &out.TemplatesRef
+		*out = new(TemplatesReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayClassSpec.
@@ -131,7 +184,7 @@ func (in *AiGatewayClassStatus) DeepCopyInto(out *AiGatewayClassStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -181,58 +234,3070 @@ func (in *AiGatewayList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AiGatewaySpec) DeepCopyInto(out *AiGatewaySpec) {
+func (in *AiGatewayQuota) DeepCopyInto(out *AiGatewayQuota) {
 	*out = *in
-	if in.AiModels != nil {
-		in, out := &in.AiModels, &out.AiModels
-		*out = make([]AiModel, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayQuota.
+func (in *AiGatewayQuota) DeepCopy() *AiGatewayQuota {
+	if in == nil {
+		return nil
 	}
+	out := new(AiGatewayQuota)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewaySpec.
-func (in *AiGatewaySpec) DeepCopy() *AiGatewaySpec {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiGatewayQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayQuotaList) DeepCopyInto(out *AiGatewayQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiGatewayQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayQuotaList.
+func (in *AiGatewayQuotaList) DeepCopy() *AiGatewayQuotaList {
 	if in == nil {
 		return nil
 	}
-	out := new(AiGatewaySpec)
+	out := new(AiGatewayQuotaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiGatewayQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AiGatewayStatus) DeepCopyInto(out *AiGatewayStatus) {
+func (in *AiGatewayQuotaSpec) DeepCopyInto(out *AiGatewayQuotaSpec) {
+	*out = *in
+	if in.MaxGateways != nil {
+		in, out := &in.MaxGateways, &out.MaxGateways
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxModels != nil {
+		in, out := &in.MaxModels, &out.MaxModels
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayQuotaSpec.
+func (in *AiGatewayQuotaSpec) DeepCopy() *AiGatewayQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayQuotaStatus) DeepCopyInto(out *AiGatewayQuotaStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = new(AiGatewayQuotaUsage)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayStatus.
-func (in *AiGatewayStatus) DeepCopy() *AiGatewayStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayQuotaStatus.
+func (in *AiGatewayQuotaStatus) DeepCopy() *AiGatewayQuotaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(AiGatewayStatus)
+	out := new(AiGatewayQuotaStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AiModel) DeepCopyInto(out *AiModel) {
+func (in *AiGatewayQuotaUsage) DeepCopyInto(out *AiGatewayQuotaUsage) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModel.
-func (in *AiModel) DeepCopy() *AiModel {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayQuotaUsage.
+func (in *AiGatewayQuotaUsage) DeepCopy() *AiGatewayQuotaUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(AiModel)
+	out := new(AiGatewayQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayRoute) DeepCopyInto(out *AiGatewayRoute) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayRoute.
+func (in *AiGatewayRoute) DeepCopy() *AiGatewayRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiGatewayRoute) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayRouteList) DeepCopyInto(out *AiGatewayRouteList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiGatewayRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayRouteList.
+func (in *AiGatewayRouteList) DeepCopy() *AiGatewayRouteList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayRouteList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiGatewayRouteList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayRouteRule) DeepCopyInto(out *AiGatewayRouteRule) {
+	*out = *in
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]PathMatch, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackendRefs != nil {
+		in, out := &in.BackendRefs, &out.BackendRefs
+		*out = make([]ModelBackendRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(MirrorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromptPolicy != nil {
+		in, out := &in.PromptPolicy, &out.PromptPolicy
+		*out = new(PromptPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayRouteRule.
+func (in *AiGatewayRouteRule) DeepCopy() *AiGatewayRouteRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayRouteRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayRouteSpec) DeepCopyInto(out *AiGatewayRouteSpec) {
+	*out = *in
+	out.ParentRef = in.ParentRef
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AiGatewayRouteRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayRouteSpec.
+func (in *AiGatewayRouteSpec) DeepCopy() *AiGatewayRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayRouteStatus) DeepCopyInto(out *AiGatewayRouteStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayRouteStatus.
+func (in *AiGatewayRouteStatus) DeepCopy() *AiGatewayRouteStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayRouteStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewaySpec) DeepCopyInto(out *AiGatewaySpec) {
+	*out = *in
+	if in.AiModels != nil {
+		in, out := &in.AiModels, &out.AiModels
+		*out = make([]AiModel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportConfigRef != nil {
+		in, out := &in.ImportConfigRef, &out.ImportConfigRef
+		*out = new(ContentSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SettingsFrom != nil {
+		in, out := &in.SettingsFrom, &out.SettingsFrom
+		*out = new(SettingsFrom)
+		**out = **in
+	}
+	if in.SecretProviderClassRef != nil {
+		in, out := &in.SecretProviderClassRef, &out.SecretProviderClassRef
+		*out = new(SecretProviderClassRef)
+		**out = **in
+	}
+	if in.ModelLibraries != nil {
+		in, out := &in.ModelLibraries, &out.ModelLibraries
+		*out = make([]ModelLibraryImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Extends != nil {
+		in, out := &in.Extends, &out.Extends
+		*out = new(ExtendsRef)
+		**out = **in
+	}
+	if in.PromptPolicy != nil {
+		in, out := &in.PromptPolicy, &out.PromptPolicy
+		*out = new(PromptPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(GatewayServiceAccount)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observability != nil {
+		in, out := &in.Observability, &out.Observability
+		*out = new(ObservabilityConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(CachingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routing != nil {
+		in, out := &in.Routing, &out.Routing
+		*out = new(RoutingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(DatabaseConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RedisRef != nil {
+		in, out := &in.RedisRef, &out.RedisRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Probes != nil {
+		in, out := &in.Probes, &out.Probes
+		*out = new(ProbesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AuthConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MasterKeySecretRef != nil {
+		in, out := &in.MasterKeySecretRef, &out.MasterKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(EgressConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ScaleToZero != nil {
+		in, out := &in.ScaleToZero, &out.ScaleToZero
+		*out = new(ScaleToZeroConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]ScalingSchedule, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(appsv1.DeploymentStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TerminationGracePeriodSeconds != nil {
+		in, out := &in.TerminationGracePeriodSeconds, &out.TerminationGracePeriodSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(ResourceTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(AuditConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(StreamingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resilience != nil {
+		in, out := &in.Resilience, &out.Resilience
+		*out = new(ResilienceConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestLimits != nil {
+		in, out := &in.RequestLimits, &out.RequestLimits
+		*out = new(RequestTokenLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficSplits != nil {
+		in, out := &in.TrafficSplits, &out.TrafficSplits
+		*out = make([]TrafficSplit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ModelGroups != nil {
+		in, out := &in.ModelGroups, &out.ModelGroups
+		*out = make([]ModelGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(HooksConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MCPServers != nil {
+		in, out := &in.MCPServers, &out.MCPServers
+		*out = make([]MCPServer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Protocols != nil {
+		in, out := &in.Protocols, &out.Protocols
+		*out = new(ProtocolsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Discovery != nil {
+		in, out := &in.Discovery, &out.Discovery
+		*out = new(DiscoveryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewaySpec.
+func (in *AiGatewaySpec) DeepCopy() *AiGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiGatewayStatus) DeepCopyInto(out *AiGatewayStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GuardrailExemptions != nil {
+		in, out := &in.GuardrailExemptions, &out.GuardrailExemptions
+		*out = make([]GuardrailExemptionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		**out = **in
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]AiGatewayAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(UsageStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficSplits != nil {
+		in, out := &in.TrafficSplits, &out.TrafficSplits
+		*out = make([]TrafficSplitStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]ModelStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScheduledReplicas != nil {
+		in, out := &in.ScheduledReplicas, &out.ScheduledReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConfigRef != nil {
+		in, out := &in.ConfigRef, &out.ConfigRef
+		*out = new(ConfigReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiGatewayStatus.
+func (in *AiGatewayStatus) DeepCopy() *AiGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModel) DeepCopyInto(out *AiModel) {
+	*out = *in
+	if in.Azure != nil {
+		in, out := &in.Azure, &out.Azure
+		*out = new(AzureConfig)
+		**out = **in
+	}
+	if in.Bedrock != nil {
+		in, out := &in.Bedrock, &out.Bedrock
+		*out = new(BedrockConfig)
+		**out = **in
+	}
+	if in.VertexAI != nil {
+		in, out := &in.VertexAI, &out.VertexAI
+		*out = new(VertexAIConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKeySecretRef != nil {
+		in, out := &in.APIKeySecretRef, &out.APIKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(BackendServiceRef)
+		**out = **in
+	}
+	if in.FailoverEndpoints != nil {
+		in, out := &in.FailoverEndpoints, &out.FailoverEndpoints
+		*out = make([]FailoverEndpoint, len(*in))
+		copy(*out, *in)
+	}
+	if in.PromptPolicy != nil {
+		in, out := &in.PromptPolicy, &out.PromptPolicy
+		*out = new(PromptPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = new(GuardrailPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxParallelRequests != nil {
+		in, out := &in.MaxParallelRequests, &out.MaxParallelRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequestLimits != nil {
+		in, out := &in.RequestLimits, &out.RequestLimits
+		*out = new(RequestTokenLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(ModelCapabilities)
+		**out = **in
+	}
+	if in.CooldownSeconds != nil {
+		in, out := &in.CooldownSeconds, &out.CooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Mirror != nil {
+		in, out := &in.Mirror, &out.Mirror
+		*out = new(MirrorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModel.
+func (in *AiModel) DeepCopy() *AiModel {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelLibrary) DeepCopyInto(out *AiModelLibrary) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelLibrary.
+func (in *AiModelLibrary) DeepCopy() *AiModelLibrary {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelLibrary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiModelLibrary) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelLibraryList) DeepCopyInto(out *AiModelLibraryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiModelLibrary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelLibraryList.
+func (in *AiModelLibraryList) DeepCopy() *AiModelLibraryList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelLibraryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiModelLibraryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelLibrarySpec) DeepCopyInto(out *AiModelLibrarySpec) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]AiModel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelLibrarySpec.
+func (in *AiModelLibrarySpec) DeepCopy() *AiModelLibrarySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelLibrarySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelLibraryStatus) DeepCopyInto(out *AiModelLibraryStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelLibraryStatus.
+func (in *AiModelLibraryStatus) DeepCopy() *AiModelLibraryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelLibraryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelPolicy) DeepCopyInto(out *AiModelPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelPolicy.
+func (in *AiModelPolicy) DeepCopy() *AiModelPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiModelPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelPolicyList) DeepCopyInto(out *AiModelPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiModelPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelPolicyList.
+func (in *AiModelPolicyList) DeepCopy() *AiModelPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiModelPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelPolicySpec) DeepCopyInto(out *AiModelPolicySpec) {
+	*out = *in
+	if in.AllowedModels != nil {
+		in, out := &in.AllowedModels, &out.AllowedModels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelPolicySpec.
+func (in *AiModelPolicySpec) DeepCopy() *AiModelPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiModelPolicyStatus) DeepCopyInto(out *AiModelPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ViolatingGateways != nil {
+		in, out := &in.ViolatingGateways, &out.ViolatingGateways
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiModelPolicyStatus.
+func (in *AiModelPolicyStatus) DeepCopy() *AiModelPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiModelPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiPromptTemplate) DeepCopyInto(out *AiPromptTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiPromptTemplate.
+func (in *AiPromptTemplate) DeepCopy() *AiPromptTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(AiPromptTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiPromptTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiPromptTemplateList) DeepCopyInto(out *AiPromptTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiPromptTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiPromptTemplateList.
+func (in *AiPromptTemplateList) DeepCopy() *AiPromptTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiPromptTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiPromptTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiPromptTemplateSpec) DeepCopyInto(out *AiPromptTemplateSpec) {
+	*out = *in
+	if in.GatewayRef != nil {
+		in, out := &in.GatewayRef, &out.GatewayRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]PromptVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiPromptTemplateSpec.
+func (in *AiPromptTemplateSpec) DeepCopy() *AiPromptTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiPromptTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiPromptTemplateStatus) DeepCopyInto(out *AiPromptTemplateStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiPromptTemplateStatus.
+func (in *AiPromptTemplateStatus) DeepCopy() *AiPromptTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiPromptTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiRateLimitPolicy) DeepCopyInto(out *AiRateLimitPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiRateLimitPolicy.
+func (in *AiRateLimitPolicy) DeepCopy() *AiRateLimitPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AiRateLimitPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiRateLimitPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiRateLimitPolicyList) DeepCopyInto(out *AiRateLimitPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiRateLimitPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiRateLimitPolicyList.
+func (in *AiRateLimitPolicyList) DeepCopy() *AiRateLimitPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiRateLimitPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiRateLimitPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiRateLimitPolicySpec) DeepCopyInto(out *AiRateLimitPolicySpec) {
+	*out = *in
+	if in.GatewayRef != nil {
+		in, out := &in.GatewayRef, &out.GatewayRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	in.Subject.DeepCopyInto(&out.Subject)
+	in.Limits.DeepCopyInto(&out.Limits)
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(SpendBudget)
+		**out = **in
+	}
+	if in.RequestLimits != nil {
+		in, out := &in.RequestLimits, &out.RequestLimits
+		*out = new(RequestTokenLimits)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiRateLimitPolicySpec.
+func (in *AiRateLimitPolicySpec) DeepCopy() *AiRateLimitPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiRateLimitPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiRateLimitPolicyStatus) DeepCopyInto(out *AiRateLimitPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BlockedKeys != nil {
+		in, out := &in.BlockedKeys, &out.BlockedKeys
+		*out = make([]BlockedKeyStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiRateLimitPolicyStatus.
+func (in *AiRateLimitPolicyStatus) DeepCopy() *AiRateLimitPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiRateLimitPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiTeam) DeepCopyInto(out *AiTeam) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiTeam.
+func (in *AiTeam) DeepCopy() *AiTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(AiTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiTeam) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiTeamList) DeepCopyInto(out *AiTeamList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiTeamList.
+func (in *AiTeamList) DeepCopy() *AiTeamList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiTeamList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiTeamList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiTeamSpec) DeepCopyInto(out *AiTeamSpec) {
+	*out = *in
+	if in.GatewayRef != nil {
+		in, out := &in.GatewayRef, &out.GatewayRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]TeamMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(SpendBudget)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiTeamSpec.
+func (in *AiTeamSpec) DeepCopy() *AiTeamSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiTeamSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiTeamStatus) DeepCopyInto(out *AiTeamStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiTeamStatus.
+func (in *AiTeamStatus) DeepCopy() *AiTeamStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiTeamStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiVirtualKey) DeepCopyInto(out *AiVirtualKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiVirtualKey.
+func (in *AiVirtualKey) DeepCopy() *AiVirtualKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AiVirtualKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiVirtualKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiVirtualKeyList) DeepCopyInto(out *AiVirtualKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AiVirtualKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiVirtualKeyList.
+func (in *AiVirtualKeyList) DeepCopy() *AiVirtualKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AiVirtualKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AiVirtualKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiVirtualKeySpec) DeepCopyInto(out *AiVirtualKeySpec) {
+	*out = *in
+	out.GatewayRef = in.GatewayRef
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiVirtualKeySpec.
+func (in *AiVirtualKeySpec) DeepCopy() *AiVirtualKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AiVirtualKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AiVirtualKeyStatus) DeepCopyInto(out *AiVirtualKeyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AiVirtualKeyStatus.
+func (in *AiVirtualKeyStatus) DeepCopy() *AiVirtualKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AiVirtualKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.SamplingPercent != nil {
+		in, out := &in.SamplingPercent, &out.SamplingPercent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditConfig.
+func (in *AuditConfig) DeepCopy() *AuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthConfig) DeepCopyInto(out *AuthConfig) {
+	*out = *in
+	if in.JWT != nil {
+		in, out := &in.JWT, &out.JWT
+		*out = new(JWTAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthConfig.
+func (in *AuthConfig) DeepCopy() *AuthConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetMemoryUtilizationPercentage != nil {
+		in, out := &in.TargetMemoryUtilizationPercentage, &out.TargetMemoryUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]v2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KEDA != nil {
+		in, out := &in.KEDA, &out.KEDA
+		*out = new(KEDAConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureConfig) DeepCopyInto(out *AzureConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureConfig.
+func (in *AzureConfig) DeepCopy() *AzureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackendServiceRef) DeepCopyInto(out *BackendServiceRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendServiceRef.
+func (in *BackendServiceRef) DeepCopy() *BackendServiceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(BackendServiceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BedrockConfig) DeepCopyInto(out *BedrockConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BedrockConfig.
+func (in *BedrockConfig) DeepCopy() *BedrockConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BedrockConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockedKeyStatus) DeepCopyInto(out *BlockedKeyStatus) {
+	*out = *in
+	in.BlockedAt.DeepCopyInto(&out.BlockedAt)
+	in.UnblockAt.DeepCopyInto(&out.UnblockAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockedKeyStatus.
+func (in *BlockedKeyStatus) DeepCopy() *BlockedKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockedKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachingConfig) DeepCopyInto(out *CachingConfig) {
+	*out = *in
+	if in.OptOut != nil {
+		in, out := &in.OptOut, &out.OptOut
+		*out = new(ConsumerSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachingConfig.
+func (in *CachingConfig) DeepCopy() *CachingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CachingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallbackConfig) DeepCopyInto(out *CallbackConfig) {
+	*out = *in
+	if in.Langfuse != nil {
+		in, out := &in.Langfuse, &out.Langfuse
+		*out = new(LangfuseCallback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Helicone != nil {
+		in, out := &in.Helicone, &out.Helicone
+		*out = new(HeliconeCallback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookCallback)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallbackConfig.
+func (in *CallbackConfig) DeepCopy() *CallbackConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CallbackConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRollout) DeepCopyInto(out *CanaryRollout) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRollout.
+func (in *CanaryRollout) DeepCopy() *CanaryRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
+	*out = *in
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStep.
+func (in *CanaryStep) DeepCopy() *CanaryStep {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircuitBreakerConfig) DeepCopyInto(out *CircuitBreakerConfig) {
+	*out = *in
+	if in.Cooldown != nil {
+		in, out := &in.Cooldown, &out.Cooldown
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircuitBreakerConfig.
+func (in *CircuitBreakerConfig) DeepCopy() *CircuitBreakerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CircuitBreakerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAiGateway) DeepCopyInto(out *ClusterAiGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAiGateway.
+func (in *ClusterAiGateway) DeepCopy() *ClusterAiGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAiGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAiGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAiGatewayList) DeepCopyInto(out *ClusterAiGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAiGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAiGatewayList.
+func (in *ClusterAiGatewayList) DeepCopy() *ClusterAiGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAiGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterAiGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAiGatewaySpec) DeepCopyInto(out *ClusterAiGatewaySpec) {
+	*out = *in
+	in.AiGatewaySpec.DeepCopyInto(&out.AiGatewaySpec)
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAiGatewaySpec.
+func (in *ClusterAiGatewaySpec) DeepCopy() *ClusterAiGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAiGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigReference) DeepCopyInto(out *ConfigReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigReference.
+func (in *ConfigReference) DeepCopy() *ConfigReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsumerSelector) DeepCopyInto(out *ConsumerSelector) {
+	*out = *in
+	if in.KeyAliases != nil {
+		in, out := &in.KeyAliases, &out.KeyAliases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsumerSelector.
+func (in *ConsumerSelector) DeepCopy() *ConsumerSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsumerSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContentSource) DeepCopyInto(out *ContentSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContentSource.
+func (in *ContentSource) DeepCopy() *ContentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ContentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatabaseConfig) DeepCopyInto(out *DatabaseConfig) {
+	*out = *in
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseConfig.
+func (in *DatabaseConfig) DeepCopy() *DatabaseConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DatabaseConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveryConfig) DeepCopyInto(out *DiscoveryConfig) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveryConfig.
+func (in *DiscoveryConfig) DeepCopy() *DiscoveryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressConfig) DeepCopyInto(out *EgressConfig) {
+	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CABundleRef != nil {
+		in, out := &in.CABundleRef, &out.CABundleRef
+		*out = new(ContentSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressConfig.
+func (in *EgressConfig) DeepCopy() *EgressConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtendsRef) DeepCopyInto(out *ExtendsRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtendsRef.
+func (in *ExtendsRef) DeepCopy() *ExtendsRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtendsRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailoverEndpoint) DeepCopyInto(out *FailoverEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailoverEndpoint.
+func (in *FailoverEndpoint) DeepCopy() *FailoverEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(FailoverEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayServiceAccount) DeepCopyInto(out *GatewayServiceAccount) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayServiceAccount.
+func (in *GatewayServiceAccount) DeepCopy() *GatewayServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailExemption) DeepCopyInto(out *GuardrailExemption) {
+	*out = *in
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Consumers.DeepCopyInto(&out.Consumers)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailExemption.
+func (in *GuardrailExemption) DeepCopy() *GuardrailExemption {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardrailExemption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailExemptionStatus) DeepCopyInto(out *GuardrailExemptionStatus) {
+	*out = *in
+	if in.Guardrails != nil {
+		in, out := &in.Guardrails, &out.Guardrails
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Consumers.DeepCopyInto(&out.Consumers)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailExemptionStatus.
+func (in *GuardrailExemptionStatus) DeepCopy() *GuardrailExemptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardrailExemptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardrailPolicy) DeepCopyInto(out *GuardrailPolicy) {
+	*out = *in
+	if in.Exemptions != nil {
+		in, out := &in.Exemptions, &out.Exemptions
+		*out = make([]GuardrailExemption, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardrailPolicy.
+func (in *GuardrailPolicy) DeepCopy() *GuardrailPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardrailPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeaderMatch) DeepCopyInto(out *HeaderMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeaderMatch.
+func (in *HeaderMatch) DeepCopy() *HeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(HeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HeliconeCallback) DeepCopyInto(out *HeliconeCallback) {
+	*out = *in
+	in.APIKeySecretRef.DeepCopyInto(&out.APIKeySecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HeliconeCallback.
+func (in *HeliconeCallback) DeepCopy() *HeliconeCallback {
+	if in == nil {
+		return nil
+	}
+	out := new(HeliconeCallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HookEndpoint) DeepCopyInto(out *HookEndpoint) {
+	*out = *in
+	if in.AuthorizationSecretRef != nil {
+		in, out := &in.AuthorizationSecretRef, &out.AuthorizationSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HookEndpoint.
+func (in *HookEndpoint) DeepCopy() *HookEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(HookEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HooksConfig) DeepCopyInto(out *HooksConfig) {
+	*out = *in
+	if in.PreCall != nil {
+		in, out := &in.PreCall, &out.PreCall
+		*out = new(HookEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostCall != nil {
+		in, out := &in.PostCall, &out.PostCall
+		*out = new(HookEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HooksConfig.
+func (in *HooksConfig) DeepCopy() *HooksConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HooksConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuth) DeepCopyInto(out *JWTAuth) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWKSSecretRef != nil {
+		in, out := &in.JWKSSecretRef, &out.JWKSSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuth.
+func (in *JWTAuth) DeepCopy() *JWTAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAConfig) DeepCopyInto(out *KEDAConfig) {
+	*out = *in
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = make([]KEDAPrometheusTrigger, len(*in))
+		copy(*out, *in)
+	}
+	if in.PollingIntervalSeconds != nil {
+		in, out := &in.PollingIntervalSeconds, &out.PollingIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CooldownPeriodSeconds != nil {
+		in, out := &in.CooldownPeriodSeconds, &out.CooldownPeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAConfig.
+func (in *KEDAConfig) DeepCopy() *KEDAConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KEDAPrometheusTrigger) DeepCopyInto(out *KEDAPrometheusTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KEDAPrometheusTrigger.
+func (in *KEDAPrometheusTrigger) DeepCopy() *KEDAPrometheusTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(KEDAPrometheusTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LangfuseCallback) DeepCopyInto(out *LangfuseCallback) {
+	*out = *in
+	in.PublicKeySecretRef.DeepCopyInto(&out.PublicKeySecretRef)
+	in.SecretKeySecretRef.DeepCopyInto(&out.SecretKeySecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LangfuseCallback.
+func (in *LangfuseCallback) DeepCopy() *LangfuseCallback {
+	if in == nil {
+		return nil
+	}
+	out := new(LangfuseCallback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LatencyBasedRoutingOptions) DeepCopyInto(out *LatencyBasedRoutingOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LatencyBasedRoutingOptions.
+func (in *LatencyBasedRoutingOptions) DeepCopy() *LatencyBasedRoutingOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LatencyBasedRoutingOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
+	*out = *in
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(RedactionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfig.
+func (in *LoggingConfig) DeepCopy() *LoggingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPServer) DeepCopyInto(out *MCPServer) {
+	*out = *in
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(BackendServiceRef)
+		**out = **in
+	}
+	if in.AuthorizationSecretRef != nil {
+		in, out := &in.AuthorizationSecretRef, &out.AuthorizationSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowedTools != nil {
+		in, out := &in.AllowedTools, &out.AllowedTools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPServer.
+func (in *MCPServer) DeepCopy() *MCPServer {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfig) DeepCopyInto(out *MetricsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfig.
+func (in *MetricsConfig) DeepCopy() *MetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorConfig) DeepCopyInto(out *MirrorConfig) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorConfig.
+func (in *MirrorConfig) DeepCopy() *MirrorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelBackendRef) DeepCopyInto(out *ModelBackendRef) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelBackendRef.
+func (in *ModelBackendRef) DeepCopy() *ModelBackendRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelBackendRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelCapabilities) DeepCopyInto(out *ModelCapabilities) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCapabilities.
+func (in *ModelCapabilities) DeepCopy() *ModelCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelGroup) DeepCopyInto(out *ModelGroup) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = new(RateLimits)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelGroup.
+func (in *ModelGroup) DeepCopy() *ModelGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelLibraryImport) DeepCopyInto(out *ModelLibraryImport) {
+	*out = *in
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelLibraryImport.
+func (in *ModelLibraryImport) DeepCopy() *ModelLibraryImport {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelLibraryImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = new(ModelCapabilities)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
+func (in *ModelStatus) DeepCopy() *ModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelUsage) DeepCopyInto(out *ModelUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelUsage.
+func (in *ModelUsage) DeepCopy() *ModelUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityConfig) DeepCopyInto(out *ObservabilityConfig) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(MetricsConfig)
+		**out = **in
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(UsageReportingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Callbacks != nil {
+		in, out := &in.Callbacks, &out.Callbacks
+		*out = make([]CallbackConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityConfig.
+func (in *ObservabilityConfig) DeepCopy() *ObservabilityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ParametersReference) DeepCopyInto(out *ParametersReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ParametersReference.
+func (in *ParametersReference) DeepCopy() *ParametersReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ParametersReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PathMatch) DeepCopyInto(out *PathMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PathMatch.
+func (in *PathMatch) DeepCopy() *PathMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(PathMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbeSettings) DeepCopyInto(out *ProbeSettings) {
+	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PeriodSeconds != nil {
+		in, out := &in.PeriodSeconds, &out.PeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SuccessThreshold != nil {
+		in, out := &in.SuccessThreshold, &out.SuccessThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbeSettings.
+func (in *ProbeSettings) DeepCopy() *ProbeSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbeSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProbesConfig) DeepCopyInto(out *ProbesConfig) {
+	*out = *in
+	if in.Liveness != nil {
+		in, out := &in.Liveness, &out.Liveness
+		*out = new(ProbeSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Readiness != nil {
+		in, out := &in.Readiness, &out.Readiness
+		*out = new(ProbeSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Startup != nil {
+		in, out := &in.Startup, &out.Startup
+		*out = new(ProbeSettings)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProbesConfig.
+func (in *ProbesConfig) DeepCopy() *ProbesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProbesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromptPolicy) DeepCopyInto(out *PromptPolicy) {
+	*out = *in
+	if in.SystemPrefix != nil {
+		in, out := &in.SystemPrefix, &out.SystemPrefix
+		*out = new(SystemPromptInjection)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptPolicy.
+func (in *PromptPolicy) DeepCopy() *PromptPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromptPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromptTemplateRef) DeepCopyInto(out *PromptTemplateRef) {
+	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptTemplateRef.
+func (in *PromptTemplateRef) DeepCopy() *PromptTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(PromptTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromptVariable) DeepCopyInto(out *PromptVariable) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromptVariable.
+func (in *PromptVariable) DeepCopy() *PromptVariable {
+	if in == nil {
+		return nil
+	}
+	out := new(PromptVariable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolsConfig) DeepCopyInto(out *ProtocolsConfig) {
+	*out = *in
+	if in.A2A != nil {
+		in, out := &in.A2A, &out.A2A
+		*out = new(A2AProtocol)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolsConfig.
+func (in *ProtocolsConfig) DeepCopy() *ProtocolsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSubject) DeepCopyInto(out *RateLimitSubject) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSubject.
+func (in *RateLimitSubject) DeepCopy() *RateLimitSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimits) DeepCopyInto(out *RateLimits) {
+	*out = *in
+	if in.RequestsPerMinute != nil {
+		in, out := &in.RequestsPerMinute, &out.RequestsPerMinute
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TokensPerMinute != nil {
+		in, out := &in.TokensPerMinute, &out.TokensPerMinute
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxParallelRequests != nil {
+		in, out := &in.MaxParallelRequests, &out.MaxParallelRequests
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimits.
+func (in *RateLimits) DeepCopy() *RateLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedactionPolicy) DeepCopyInto(out *RedactionPolicy) {
+	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedactionPolicy.
+func (in *RedactionPolicy) DeepCopy() *RedactionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RedactionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestTokenLimits) DeepCopyInto(out *RequestTokenLimits) {
+	*out = *in
+	if in.MaxInputTokens != nil {
+		in, out := &in.MaxInputTokens, &out.MaxInputTokens
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxOutputTokens != nil {
+		in, out := &in.MaxOutputTokens, &out.MaxOutputTokens
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestTokenLimits.
+func (in *RequestTokenLimits) DeepCopy() *RequestTokenLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestTokenLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResilienceConfig) DeepCopyInto(out *ResilienceConfig) {
+	*out = *in
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CircuitBreakerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResilienceConfig.
+func (in *ResilienceConfig) DeepCopy() *ResilienceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResilienceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceMetadata) DeepCopyInto(out *ResourceMetadata) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceMetadata.
+func (in *ResourceMetadata) DeepCopy() *ResourceMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTemplate) DeepCopyInto(out *ResourceTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTemplate.
+func (in *ResourceTemplate) DeepCopy() *ResourceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutConfig) DeepCopyInto(out *RolloutConfig) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRollout)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutConfig.
+func (in *RolloutConfig) DeepCopy() *RolloutConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingConfig) DeepCopyInto(out *RoutingConfig) {
+	*out = *in
+	if in.LatencyBased != nil {
+		in, out := &in.LatencyBased, &out.LatencyBased
+		*out = new(LatencyBasedRoutingOptions)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RoutingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingConfig.
+func (in *RoutingConfig) DeepCopy() *RoutingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoutingRule) DeepCopyInto(out *RoutingRule) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]HeaderMatch, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoutingRule.
+func (in *RoutingRule) DeepCopy() *RoutingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RoutingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleToZeroConfig) DeepCopyInto(out *ScaleToZeroConfig) {
+	*out = *in
+	out.IdleAfter = in.IdleAfter
+	if in.ActivationTimeout != nil {
+		in, out := &in.ActivationTimeout, &out.ActivationTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleToZeroConfig.
+func (in *ScaleToZeroConfig) DeepCopy() *ScaleToZeroConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleToZeroConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingSchedule) DeepCopyInto(out *ScalingSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingSchedule.
+func (in *ScalingSchedule) DeepCopy() *ScalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretProviderClassRef) DeepCopyInto(out *SecretProviderClassRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretProviderClassRef.
+func (in *SecretProviderClassRef) DeepCopy() *SecretProviderClassRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretProviderClassRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SettingsFrom) DeepCopyInto(out *SettingsFrom) {
+	*out = *in
+	out.ConfigMapRef = in.ConfigMapRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SettingsFrom.
+func (in *SettingsFrom) DeepCopy() *SettingsFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(SettingsFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpendBudget) DeepCopyInto(out *SpendBudget) {
+	*out = *in
+	out.Window = in.Window
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpendBudget.
+func (in *SpendBudget) DeepCopy() *SpendBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(SpendBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBackend) DeepCopyInto(out *SplitBackend) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBackend.
+func (in *SplitBackend) DeepCopy() *SplitBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBackendStatus) DeepCopyInto(out *SplitBackendStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBackendStatus.
+func (in *SplitBackendStatus) DeepCopy() *SplitBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamingConfig) DeepCopyInto(out *StreamingConfig) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.KeepaliveInterval != nil {
+		in, out := &in.KeepaliveInterval, &out.KeepaliveInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamingConfig.
+func (in *StreamingConfig) DeepCopy() *StreamingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemPromptInjection) DeepCopyInto(out *SystemPromptInjection) {
+	*out = *in
+	in.ContentSource.DeepCopyInto(&out.ContentSource)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(PromptTemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemPromptInjection.
+func (in *SystemPromptInjection) DeepCopy() *SystemPromptInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemPromptInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamMember) DeepCopyInto(out *TeamMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamMember.
+func (in *TeamMember) DeepCopy() *TeamMember {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplatesReference) DeepCopyInto(out *TemplatesReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatesReference.
+func (in *TemplatesReference) DeepCopy() *TemplatesReference {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplatesReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplit) DeepCopyInto(out *TrafficSplit) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]SplitBackend, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplit.
+func (in *TrafficSplit) DeepCopy() *TrafficSplit {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficSplitStatus) DeepCopyInto(out *TrafficSplitStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]SplitBackendStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficSplitStatus.
+func (in *TrafficSplitStatus) DeepCopy() *TrafficSplitStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficSplitStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageReportingConfig) DeepCopyInto(out *UsageReportingConfig) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageReportingConfig.
+func (in *UsageReportingConfig) DeepCopy() *UsageReportingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageReportingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UsageStatus) DeepCopyInto(out *UsageStatus) {
+	*out = *in
+	out.Window = in.Window
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Models != nil {
+		in, out := &in.Models, &out.Models
+		*out = make([]ModelUsage, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsageStatus.
+func (in *UsageStatus) DeepCopy() *UsageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UsageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VertexAIConfig) DeepCopyInto(out *VertexAIConfig) {
+	*out = *in
+	if in.CredentialsSecretRef != nil {
+		in, out := &in.CredentialsSecretRef, &out.CredentialsSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VertexAIConfig.
+func (in *VertexAIConfig) DeepCopy() *VertexAIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VertexAIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookCallback) DeepCopyInto(out *WebhookCallback) {
+	*out = *in
+	if in.AuthorizationSecretRef != nil {
+		in, out := &in.AuthorizationSecretRef, &out.AuthorizationSecretRef
+		*out = new(v1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookCallback.
+func (in *WebhookCallback) DeepCopy() *WebhookCallback {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookCallback)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// kubectl-aigateway is a kubectl plugin with support tooling for AI gateways.
+//
+// Usage:
+//
+//	kubectl aigateway support-bundle -n <namespace> <name> [-o <file>]
+//	kubectl aigateway render -n <namespace> <name>
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
+	_ "k8s.io/client-go/plugin/pkg/client/auth"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	agenticlayeraiv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/render"
+	"github.com/agentic-layer/ai-gateway-operator/internal/supportbundle"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(agenticlayeraiv1alpha1.AddToScheme(scheme))
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "support-bundle":
+		err = runSupportBundle(os.Args[2:])
+	case "render":
+		err = runRender(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	_, _ = fmt.Fprintln(os.Stderr, "Usage: kubectl aigateway support-bundle [-n namespace] [-o file] <name>")
+	_, _ = fmt.Fprintln(os.Stderr, "       kubectl aigateway render [-n namespace] [-class-namespace namespace] <name>")
+}
+
+// newClient creates a client for the cluster of the current kubeconfig context.
+func newClient() (client.Client, *rest.Config, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return c, cfg, nil
+}
+
+// runRender writes the resources derived from an AiGateway as YAML to stdout without applying them.
+func runRender(args []string) error {
+	flags := flag.NewFlagSet("render", flag.ExitOnError)
+	namespace := flags.String("n", "default", "The namespace of the AiGateway.")
+	classNamespace := flags.String("class-namespace", agenticlayeraiv1alpha1.DefaultClassNamespace,
+		"The namespace of the AiGatewayClasses, the --class-namespace of the operator.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		usage()
+		return fmt.Errorf("expected exactly one AiGateway name, got %d", flags.NArg())
+	}
+
+	c, _, err := newClient()
+	if err != nil {
+		return err
+	}
+	renderer := &render.Renderer{Client: c, ClassNamespace: *classNamespace}
+	objs, err := renderer.Render(context.Background(), types.NamespacedName{
+		Namespace: *namespace,
+		Name:      flags.Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+	return render.WriteYAML(os.Stdout, objs)
+}
+
+// runSupportBundle collects the support bundle of an AiGateway into a tar.gz archive.
+func runSupportBundle(args []string) error {
+	flags := flag.NewFlagSet("support-bundle", flag.ExitOnError)
+	namespace := flags.String("n", "default", "The namespace of the AiGateway.")
+	output := flags.String("o", "", "The archive to write. Defaults to <namespace>-<name>-support-bundle.tar.gz.")
+	tailLines := flags.Int64("tail", 1000, "The number of log lines to collect per container.")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		usage()
+		return fmt.Errorf("expected exactly one AiGateway name, got %d", flags.NArg())
+	}
+
+	c, cfg, err := newClient()
+	if err != nil {
+		return err
+	}
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	collector := &supportbundle.Collector{Client: c, Clientset: clientset, LogTailLines: *tailLines}
+	bundle, err := collector.Collect(context.Background(), types.NamespacedName{
+		Namespace: *namespace,
+		Name:      flags.Arg(0),
+	})
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		*output = bundle.Name + ".tar.gz"
+	}
+	f, err := os.Create(*output)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", *output, err)
+	}
+	defer func() { _ = f.Close() }()
+	if err := bundle.WriteArchive(f); err != nil {
+		return err
+	}
+
+	fmt.Printf("Support bundle written to %s\n", *output)
+	return nil
+}
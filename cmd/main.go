@@ -17,17 +17,31 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+	// Embed the time zone database for the time zones of AiGateway scaling schedules.
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
@@ -38,6 +52,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	agenticlayeraiv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/controller"
+	"github.com/agentic-layer/ai-gateway-operator/internal/kong"
+	"github.com/agentic-layer/ai-gateway-operator/internal/providers"
 	webhookv1alpha1 "github.com/agentic-layer/ai-gateway-operator/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
@@ -60,18 +77,60 @@ func main() {
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
 	var enableLeaderElection bool
+	var leaseDuration, renewDeadline, retryPeriod time.Duration
+	var disableControllers string
 	var probeAddr string
-	var secureMetrics bool
+	var enablePprof bool
+	var pprofAddr string
+	var enableTracing bool
+	var logFormat string
+	var controllerName string
+	var catalogSyncInterval time.Duration
+	var verifyCredentials bool
+	var admissionPolicies bool
+	var resolveDefaultClass bool
+	var enableKong bool
+	var kongIngressClass string
+	var shard string
+	var classNamespace string
+	var secureMetrics, metricsAuth bool
+	var metricsTLSMinVersion string
 	var enableHTTP2 bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.BoolVar(&enablePprof, "enable-pprof", false,
+		"If set, the pprof profiling endpoints are served on --pprof-bind-address to diagnose memory and CPU issues.")
+	flag.StringVar(&pprofAddr, "pprof-bind-address", "127.0.0.1:6060",
+		"The address the pprof endpoint binds to if enabled. It binds to localhost by default, "+
+			"use kubectl port-forward to reach it.")
+	flag.BoolVar(&enableTracing, "enable-tracing", false,
+		"If set, OpenTelemetry spans of admission requests and the API calls made to handle them are exported via "+
+			"OTLP/gRPC. The exporter is configured with the standard OTEL_EXPORTER_OTLP_* environment variables.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&leaseDuration, "leader-elect-lease-duration", 15*time.Second,
+		"The duration non-leader candidates wait after the last renewal before taking over the leadership. "+
+			"Lower values speed up failover at the cost of more API requests.")
+	flag.DurationVar(&renewDeadline, "leader-elect-renew-deadline", 10*time.Second,
+		"The duration the leader retries renewing the leadership before giving it up. "+
+			"Must be less than --leader-elect-lease-duration.")
+	flag.DurationVar(&retryPeriod, "leader-elect-retry-period", 2*time.Second,
+		"The duration candidates wait between attempts to acquire or renew the leadership. "+
+			"Must be less than --leader-elect-renew-deadline.")
+	flag.StringVar(&disableControllers, "disable-controllers", "",
+		"A comma-separated list of controllers not to run, of "+strings.Join(controllerNames, ", ")+
+			". Disable all of them to run a webhook-only instance; set ENABLE_WEBHOOKS=false for a "+
+			"controller-only instance.")
 	flag.BoolVar(&secureMetrics, "metrics-secure", true,
 		"If set, the metrics endpoint is served securely via HTTPS. Use --metrics-secure=false to use HTTP instead.")
+	flag.BoolVar(&metricsAuth, "metrics-auth", true,
+		"If set, scrapes of the secure metrics endpoint are authenticated and authorized with TokenReviews and "+
+			"SubjectAccessReviews. Use --metrics-auth=false to serve metrics via HTTPS without authentication.")
+	flag.StringVar(&metricsTLSMinVersion, "metrics-tls-min-version", "1.2",
+		"The minimum TLS version of the secure metrics endpoint, either 1.2 or 1.3.")
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
@@ -81,13 +140,84 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&controllerName, "controller-name", "",
+		"The spec.controller of the AiGatewayClasses handled in this cluster. If set, the Accepted condition of "+
+			"all AiGatewayClasses is maintained, marking classes of other controllers as Unsupported.")
+	flag.DurationVar(&catalogSyncInterval, "provider-catalog-sync-interval", 0,
+		"The interval in which the model lists of the providers with an API key in the environment, e.g. "+
+			"OPENAI_API_KEY, are compared with the models of the AiGateways. Disabled if 0.")
+	flag.BoolVar(&verifyCredentials, "verify-provider-credentials", false,
+		"If set, the AiGateway webhook lists the models of the provider of every model with an apiKeySecretRef "+
+			"and warns if the provider rejects the API key.")
+	flag.BoolVar(&admissionPolicies, "admission-policies", false,
+		"If set, AiGateways and ClusterAiGateways are validated by a ValidatingAdmissionPolicy the manager "+
+			"creates instead of the validating webhook, so their validation does not depend on the availability "+
+			"of the manager. The policy only checks "+webhookv1alpha1.AdmissionPolicyChecks+". All other checks "+
+			"of the webhook are skipped, e.g. of duplicate model deployments, settingsFrom, egress, schedules, "+
+			"autoscaling, routing and modelGroups and against other objects such as AiModelPolicies and "+
+			"AiGatewayQuotas, and no warnings are returned.")
+	flag.BoolVar(&resolveDefaultClass, "resolve-default-class", false,
+		"If set, several AiGatewayClasses may be marked as default: the webhook only warns, AiGateways without "+
+			"aiGatewayClassName use the oldest default class, and the Default condition of the classes shows which.")
+	flag.BoolVar(&enableKong, "enable-kong", false,
+		"If set, AiGateways of classes with the controller "+kong.ControllerName+" are implemented with Kong "+
+			"routes and ai-proxy plugins, served by an existing Kong ingress controller.")
+	flag.StringVar(&kongIngressClass, "kong-ingress-class", "kong",
+		"The ingress class of the Kong ingress controller serving the routes of the Kong implementation.")
+	flag.StringVar(&shard, "shard", "",
+		"The shard of the AiGateways reconciled by this manager, the value of their "+agenticlayeraiv1alpha1.ShardLabel+
+			" label. Each shard has its own leader. The default shard reconciles the AiGateways without the label "+
+			"and is the only one maintaining the status of the AiGatewayClasses.")
+	flag.StringVar(&classNamespace, "class-namespace", agenticlayeraiv1alpha1.DefaultClassNamespace,
+		"The namespace of the AiGatewayClasses. AiGateways reference classes by name only, which are resolved "+
+			"in this namespace; classes in other namespaces are not used.")
+	flag.StringVar(&logFormat, "log-format", "console",
+		"The format of the logs, either console or json. It takes precedence over --zap-encoder.")
 	opts := zap.Options{
 		Development: true,
 	}
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
-	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	logFormats := map[string]zap.Opts{"console": zap.ConsoleEncoder(), "json": zap.JSONEncoder()}
+	logEncoder, ok := logFormats[logFormat]
+	if !ok {
+		logEncoder = logFormats["console"]
+	}
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts), logEncoder))
+	if !ok {
+		setupLog.Error(nil, "unsupported log format, must be console or json", "log-format", logFormat)
+		os.Exit(1)
+	}
+	if errs := validation.IsDNS1123Label(shard); shard != "" && len(errs) > 0 {
+		setupLog.Error(nil, "invalid shard", "shard", shard, "errors", errs)
+		os.Exit(1)
+	}
+	if leaseDuration <= renewDeadline || renewDeadline <= retryPeriod {
+		setupLog.Error(nil, "the leader election durations must satisfy "+
+			"lease duration > renew deadline > retry period", "leader-elect-lease-duration", leaseDuration,
+			"leader-elect-renew-deadline", renewDeadline, "leader-elect-retry-period", retryPeriod)
+		os.Exit(1)
+	}
+	disabled, err := parseDisabledControllers(disableControllers)
+	if err != nil {
+		setupLog.Error(err, "invalid --disable-controllers")
+		os.Exit(1)
+	}
+	leaderElectionID := "4b1f9b08.agentic-layer.ai"
+	if shard != "" {
+		leaderElectionID = shard + "." + leaderElectionID
+	}
+
+	ctx := ctrl.SetupSignalHandler()
+	shutdownTracing := func(context.Context) error { return nil }
+	if enableTracing {
+		var err error
+		if shutdownTracing, err = setupTracing(ctx); err != nil {
+			setupLog.Error(err, "unable to set up tracing")
+			os.Exit(1)
+		}
+	}
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -140,10 +270,20 @@ func main() {
 	metricsServerOptions := metricsserver.Options{
 		BindAddress:   metricsAddr,
 		SecureServing: secureMetrics,
-		TLSOpts:       tlsOpts,
 	}
 
-	if secureMetrics {
+	tlsVersions := map[string]uint16{"1.2": tls.VersionTLS12, "1.3": tls.VersionTLS13}
+	minVersion, ok := tlsVersions[metricsTLSMinVersion]
+	if !ok {
+		setupLog.Error(nil, "unsupported metrics TLS version, must be 1.2 or 1.3",
+			"metrics-tls-min-version", metricsTLSMinVersion)
+		os.Exit(1)
+	}
+	metricsServerOptions.TLSOpts = append(slices.Clone(tlsOpts), func(c *tls.Config) {
+		c.MinVersion = minVersion
+	})
+
+	if secureMetrics && metricsAuth {
 		// FilterProvider is used to protect the metrics endpoint with authn/authz.
 		// These configurations ensure that only authorized users and service accounts
 		// can access the metrics endpoint. The RBAC are configured in 'config/rbac/kustomization.yaml'. More info:
@@ -178,13 +318,22 @@ func main() {
 		})
 	}
 
+	// pprof exposes internals of the manager, so it is disabled unless explicitly enabled.
+	if !enablePprof {
+		pprofAddr = ""
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
+		PprofBindAddress:       pprofAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "4b1f9b08.agentic-layer.ai",
+		LeaderElectionID:       leaderElectionID,
+		LeaseDuration:          &leaseDuration,
+		RenewDeadline:          &renewDeadline,
+		RetryPeriod:            &retryPeriod,
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -204,12 +353,117 @@ func main() {
 
 	// nolint:goconst
 	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
-		if err := webhookv1alpha1.SetupAiGatewayWebhookWithManager(mgr); err != nil {
-			setupLog.Error(err, "unable to create webhook", "webhook", "AiGateway")
+		if admissionPolicies {
+			if err := webhookv1alpha1.SetupAdmissionPoliciesWithManager(mgr, resolveDefaultClass,
+				classNamespace); err != nil {
+				setupLog.Error(err, "unable to create admission policies")
+				os.Exit(1)
+			}
+		} else {
+			if err := webhookv1alpha1.SetupAiGatewayWebhookWithManager(mgr, verifyCredentials,
+				classNamespace); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "AiGateway")
+				os.Exit(1)
+			}
+			if err := webhookv1alpha1.SetupAiGatewayClassWebhookWithManager(mgr, resolveDefaultClass,
+				classNamespace); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "AiGatewayClass")
+				os.Exit(1)
+			}
+			if err := webhookv1alpha1.SetupClusterAiGatewayWebhookWithManager(mgr); err != nil {
+				setupLog.Error(err, "unable to create webhook", "webhook", "ClusterAiGateway")
+				os.Exit(1)
+			}
+		}
+		if err := webhookv1alpha1.SetupAiRateLimitPolicyWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AiRateLimitPolicy")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupAiGatewayRouteWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AiGatewayRoute")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupAiTeamWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AiTeam")
+			os.Exit(1)
+		}
+		if err := webhookv1alpha1.SetupAiVirtualKeyWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AiVirtualKey")
 			os.Exit(1)
 		}
-		if err := webhookv1alpha1.SetupAiGatewayClassWebhookWithManager(mgr); err != nil {
-			setupLog.Error(err, "unable to create webhook", "webhook", "AiGatewayClass")
+		if err := webhookv1alpha1.SetupAiPromptTemplateWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AiPromptTemplate")
+			os.Exit(1)
+		}
+	}
+	var builtinControllers []string
+	if enableKong {
+		builtinControllers = append(builtinControllers, kong.ControllerName)
+		if controllerName == "" {
+			controllerName = kong.ControllerName
+		}
+	}
+	if enableKong && !disabled["kong-aigateway"] {
+		if err := (&controller.KongAiGatewayReconciler{
+			Client:           mgr.GetClient(),
+			Recorder:         mgr.GetEventRecorderFor("kong-aigateway-controller"),
+			APIReader:        mgr.GetAPIReader(),
+			IngressClassName: kongIngressClass,
+			Shard:            shard,
+			ClassNamespace:   classNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "KongAiGateway")
+			os.Exit(1)
+		}
+	}
+	if !disabled["aigateway-metrics"] {
+		if err := (&controller.GatewayMetricsReconciler{
+			Client: mgr.GetClient(),
+			Shard:  shard,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AiGatewayMetrics")
+			os.Exit(1)
+		}
+	}
+	if !disabled["aigateway-schedules"] {
+		if err := (&controller.ScheduleReconciler{
+			Client: mgr.GetClient(),
+			Shard:  shard,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AiGatewaySchedules")
+			os.Exit(1)
+		}
+	}
+	if controllerName != "" && shard == "" && !disabled["aigatewayclass"] {
+		if err := (&controller.AiGatewayClassReconciler{
+			Client:                    mgr.GetClient(),
+			ControllerName:            controllerName,
+			AdditionalControllerNames: builtinControllers,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "AiGatewayClass")
+			os.Exit(1)
+		}
+	}
+	if resolveDefaultClass && shard == "" && !disabled["aigatewayclass-default"] {
+		if err := (&controller.DefaultClassReconciler{
+			Client:         mgr.GetClient(),
+			ClassNamespace: classNamespace,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DefaultClass")
+			os.Exit(1)
+		}
+	}
+	if catalogSyncInterval > 0 && !disabled["provider-catalog-sync"] {
+		listers := providers.ModelListersFromEnv()
+		setupLog.Info("Syncing provider model catalogs", "providers", len(listers), "interval", catalogSyncInterval)
+		if err := mgr.Add(&controller.ModelCatalogSync{
+			Client:    mgr.GetClient(),
+			Recorder:  mgr.GetEventRecorderFor("ai-gateway-operator"),
+			Providers: listers,
+			Interval:  catalogSyncInterval,
+			Shard:     shard,
+		}); err != nil {
+			setupLog.Error(err, "unable to add provider model catalog sync to manager")
 			os.Exit(1)
 		}
 	}
@@ -241,8 +495,57 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	err = mgr.Start(ctx)
+
+	// The signal handler context is done at this point, flush the remaining spans with a fresh one.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		setupLog.Error(err, "unable to flush spans")
+	}
+	cancel()
+	if err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
+
+// controllerNames are the controllers that can be disabled with --disable-controllers.
+var controllerNames = []string{"aigatewayclass", "aigatewayclass-default", "aigateway-metrics",
+	"aigateway-schedules", "kong-aigateway", "provider-catalog-sync"}
+
+// parseDisabledControllers parses the value of --disable-controllers and rejects unknown controllers, so that a
+// typo does not leave a controller running unnoticed.
+func parseDisabledControllers(value string) (map[string]bool, error) {
+	disabled := map[string]bool{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(controllerNames, name) {
+			return nil, fmt.Errorf("unknown controller %q, known controllers are %s",
+				name, strings.Join(controllerNames, ", "))
+		}
+		disabled[name] = true
+	}
+	return disabled, nil
+}
+
+// setupTracing registers a global TracerProvider exporting spans via OTLP/gRPC and the W3C trace context
+// propagator. It returns the function flushing the remaining spans on shutdown.
+func setupTracing(ctx context.Context) (func(context.Context) error, error) {
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence over the default service name.
+	res, err := resource.New(ctx, resource.WithAttributes(semconv.ServiceName("ai-gateway-operator")),
+		resource.WithFromEnv(), resource.WithTelemetrySDK())
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider.Shutdown, nil
+}
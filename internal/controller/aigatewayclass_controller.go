@@ -0,0 +1,82 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package controller contains the reconcilers of this operator. The gateways themselves are reconciled by the
+// implementation operators; this operator reports whether AiGatewayClasses are handled and only reconciles the
+// gateways of the opt-in Kong implementation.
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// AiGatewayClassReconciler sets the Accepted condition and the observed generation of AiGatewayClasses, so users
+// see whether the gateways of a class are handled.
+type AiGatewayClassReconciler struct {
+	client.Client
+
+	// ControllerName is the spec.controller of the classes handled in this cluster.
+	ControllerName string
+	// AdditionalControllerNames are the spec.controller of the classes handled by the built-in implementations
+	// enabled in this operator, e.g. the Kong implementation.
+	AdditionalControllerNames []string
+}
+
+// Reconcile updates the status of the AiGatewayClass if its Accepted condition or generation changed.
+func (r *AiGatewayClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var aiGatewayClass gatewayv1alpha1.AiGatewayClass
+	if err := r.Get(ctx, req.NamespacedName, &aiGatewayClass); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log := logf.FromContext(ctx).WithValues("generation", aiGatewayClass.Generation)
+
+	controllerName := r.ControllerName
+	for _, name := range r.AdditionalControllerNames {
+		if aiGatewayClass.HandledBy(name) {
+			controllerName = name
+		}
+	}
+	condition := aiGatewayClass.AcceptedCondition(controllerName)
+	changed := meta.SetStatusCondition(&aiGatewayClass.Status.Conditions, condition)
+	if !changed && aiGatewayClass.Status.ObservedGeneration == aiGatewayClass.Generation {
+		return ctrl.Result{}, nil
+	}
+	aiGatewayClass.Status.ObservedGeneration = aiGatewayClass.Generation
+
+	log.Info("Updating AiGatewayClass status", "accepted", condition.Status, "reason", condition.Reason)
+	if err := r.Status().Update(ctx, &aiGatewayClass); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the reconciler in the manager. Status updates don't change the generation of a
+// class and therefore don't trigger a reconcile.
+func (r *AiGatewayClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha1.AiGatewayClass{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Named("aigatewayclass").
+		Complete(r)
+}
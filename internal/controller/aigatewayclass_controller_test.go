@@ -0,0 +1,106 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiGatewayClass Controller", func() {
+	const controllerName = "litellm.agentic-layer.ai/controller"
+
+	var (
+		ctx        context.Context
+		reconciler *AiGatewayClassReconciler
+	)
+
+	reconcile := func(name string) *gatewayv1alpha1.AiGatewayClass {
+		key := types.NamespacedName{Namespace: "default", Name: name}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		var aiGatewayClass gatewayv1alpha1.AiGatewayClass
+		Expect(reconciler.Get(ctx, key, &aiGatewayClass)).To(Succeed())
+		return &aiGatewayClass
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		newClass := func(name, controller string) *gatewayv1alpha1.AiGatewayClass {
+			return &gatewayv1alpha1.AiGatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1},
+				Spec:       gatewayv1alpha1.AiGatewayClassSpec{Controller: controller},
+			}
+		}
+		reconciler = &AiGatewayClassReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(newClass("litellm", controllerName), newClass("envoy", "envoy.agentic-layer.ai/controller")).
+				WithStatusSubresource(&gatewayv1alpha1.AiGatewayClass{}).Build(),
+			ControllerName: controllerName,
+		}
+	})
+
+	It("Should accept a class of this operator", func() {
+		aiGatewayClass := reconcile("litellm")
+
+		Expect(aiGatewayClass.Status.ObservedGeneration).To(Equal(aiGatewayClass.Generation))
+		condition := meta.FindStatusCondition(aiGatewayClass.Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionAccepted)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.AiGatewayClassReasonAccepted))
+	})
+
+	It("Should mark a class of another controller as unsupported", func() {
+		aiGatewayClass := reconcile("envoy")
+
+		condition := meta.FindStatusCondition(aiGatewayClass.Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionAccepted)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.AiGatewayClassReasonUnsupported))
+	})
+
+	It("Should accept a class of a built-in implementation", func() {
+		reconciler.AdditionalControllerNames = []string{"envoy.agentic-layer.ai/controller"}
+		aiGatewayClass := reconcile("envoy")
+
+		condition := meta.FindStatusCondition(aiGatewayClass.Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionAccepted)
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("envoy.agentic-layer.ai/controller"))
+	})
+
+	It("Should not update the status if nothing changed", func() {
+		resourceVersion := reconcile("litellm").ResourceVersion
+		Expect(reconcile("litellm").ResourceVersion).To(Equal(resourceVersion))
+	})
+})
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// DefaultClassReconciler sets the Default condition of the AiGatewayClasses marked as default, if several classes
+// may be marked: True for the class resolved by ResolveDefaultClass and False for the others. The condition is
+// removed once a class is no longer marked. Classes outside the class namespace are never the default class and
+// have no condition.
+type DefaultClassReconciler struct {
+	client.Client
+
+	// ClassNamespace is the namespace of the AiGatewayClasses, see gatewayv1alpha1.ResolveClass.
+	ClassNamespace string
+}
+
+// Reconcile updates the Default condition of the AiGatewayClass if it changed.
+func (r *DefaultClassReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var aiGatewayClass gatewayv1alpha1.AiGatewayClass
+	if err := r.Get(ctx, req.NamespacedName, &aiGatewayClass); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	log := logf.FromContext(ctx).WithValues("generation", aiGatewayClass.Generation)
+
+	var changed bool
+	if aiGatewayClass.IsDefault() && aiGatewayClass.Namespace == r.ClassNamespace {
+		var classes gatewayv1alpha1.AiGatewayClassList
+		if err := r.List(ctx, &classes, client.InNamespace(r.ClassNamespace)); err != nil {
+			return ctrl.Result{}, err
+		}
+		defaultClass := gatewayv1alpha1.ResolveDefaultClass(classes.Items, r.ClassNamespace)
+		condition := aiGatewayClass.DefaultCondition(defaultClass)
+		changed = meta.SetStatusCondition(&aiGatewayClass.Status.Conditions, condition)
+		if changed {
+			log.Info("Updating Default condition of AiGatewayClass", "default", condition.Status,
+				"reason", condition.Reason)
+		}
+	} else {
+		changed = meta.RemoveStatusCondition(&aiGatewayClass.Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionDefault)
+	}
+	if !changed {
+		return ctrl.Result{}, nil
+	}
+	if err := r.Status().Update(ctx, &aiGatewayClass); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// SetupWithManager registers the reconciler in the manager. Whether a class is the default depends on the other
+// classes marked as default, so every created or deleted class and every change of the annotations of a class
+// reconciles all classes marked as default.
+func (r *DefaultClassReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha1.AiGatewayClass{}, builder.WithPredicates(predicate.AnnotationChangedPredicate{})).
+		Watches(&gatewayv1alpha1.AiGatewayClass{}, handler.EnqueueRequestsFromMapFunc(r.defaultClasses),
+			builder.WithPredicates(predicate.AnnotationChangedPredicate{})).
+		Named("aigatewayclass-default").
+		Complete(r)
+}
+
+// defaultClasses maps a changed AiGatewayClass to the classes of the class namespace marked as default.
+func (r *DefaultClassReconciler) defaultClasses(ctx context.Context, _ client.Object) []reconcile.Request {
+	var classes gatewayv1alpha1.AiGatewayClassList
+	if err := r.List(ctx, &classes, client.InNamespace(r.ClassNamespace)); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list AiGatewayClasses")
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, aiGatewayClass := range classes.Items {
+		if aiGatewayClass.IsDefault() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&aiGatewayClass)})
+		}
+	}
+	return requests
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Default AiGatewayClass Controller", func() {
+	var (
+		ctx        context.Context
+		reconciler *DefaultClassReconciler
+	)
+
+	reconcileIn := func(namespace, name string) *gatewayv1alpha1.AiGatewayClass {
+		key := types.NamespacedName{Namespace: namespace, Name: name}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		var aiGatewayClass gatewayv1alpha1.AiGatewayClass
+		Expect(reconciler.Get(ctx, key, &aiGatewayClass)).To(Succeed())
+		return &aiGatewayClass
+	}
+	reconcile := func(name string) *gatewayv1alpha1.AiGatewayClass {
+		return reconcileIn("platform", name)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		newClass := func(name string, age time.Duration, isDefault bool) *gatewayv1alpha1.AiGatewayClass {
+			aiGatewayClass := &gatewayv1alpha1.AiGatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:         "platform",
+					Name:              name,
+					CreationTimestamp: metav1.NewTime(time.Now().Add(-age).Truncate(time.Second)),
+				},
+				Spec: gatewayv1alpha1.AiGatewayClassSpec{Controller: "litellm.agentic-layer.ai/controller"},
+			}
+			if isDefault {
+				aiGatewayClass.Annotations = map[string]string{gatewayv1alpha1.DefaultClassAnnotation: "true"}
+			}
+			return aiGatewayClass
+		}
+		unmarked := newClass("unmarked", 3*time.Hour, false)
+		unmarked.Status.Conditions = []metav1.Condition{{
+			Type:               gatewayv1alpha1.AiGatewayClassConditionDefault,
+			Status:             metav1.ConditionTrue,
+			Reason:             gatewayv1alpha1.AiGatewayClassReasonDefault,
+			LastTransitionTime: metav1.Now(),
+		}}
+		team := newClass("team", 4*time.Hour, true)
+		team.Namespace = "team-a"
+		reconciler = &DefaultClassReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(newClass("old", 2*time.Hour, true), newClass("new", time.Hour, true), unmarked, team).
+				WithStatusSubresource(&gatewayv1alpha1.AiGatewayClass{}).Build(),
+			ClassNamespace: "platform",
+		}
+	})
+
+	It("Should mark the oldest default class as the default", func() {
+		condition := meta.FindStatusCondition(reconcile("old").Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionDefault)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.AiGatewayClassReasonDefault))
+	})
+
+	It("Should mark newer default classes as superseded", func() {
+		condition := meta.FindStatusCondition(reconcile("new").Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionDefault)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.AiGatewayClassReasonSuperseded))
+		Expect(condition.Message).To(ContainSubstring(`AiGatewayClass "old"`))
+	})
+
+	It("Should remove the condition of a class no longer marked as default", func() {
+		Expect(meta.FindStatusCondition(reconcile("unmarked").Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionDefault)).To(BeNil())
+	})
+
+	It("Should ignore default classes outside the class namespace", func() {
+		Expect(meta.FindStatusCondition(reconcileIn("team-a", "team").Status.Conditions,
+			gatewayv1alpha1.AiGatewayClassConditionDefault)).To(BeNil())
+	})
+
+	It("Should map a changed class to all default classes", func() {
+		requests := reconciler.defaultClasses(ctx, nil)
+		Expect(requests).To(ConsistOf(
+			ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "platform", Name: "old"}},
+			ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "platform", Name: "new"}},
+		))
+	})
+})
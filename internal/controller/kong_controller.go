@@ -0,0 +1,347 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/csaupgrade"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/kong"
+	"github.com/agentic-layer/ai-gateway-operator/internal/render"
+)
+
+const (
+	// KongReasonProgrammed is the reason of the Ready condition of gateways whose Kong resources are up to date.
+	KongReasonProgrammed = "Programmed"
+	// KongReasonConflict is the reason of the Ready condition of gateways with a Kong resource of which another
+	// field manager owns a field the reconciler sets to a different value.
+	KongReasonConflict = "Conflict"
+)
+
+// FieldManager is the field manager of the server-side apply requests of the reconcilers. Fields of the managed
+// resources that are not rendered by the reconcilers, e.g. annotations added by other tooling, are left alone.
+const FieldManager = "ai-gateway-operator"
+
+// legacyFieldManager is the field manager of the updates of the resources created before the reconcilers used
+// server-side apply: the API server derived it from the user agent, the name of the manager binary. Its fields are
+// migrated to FieldManager, which would otherwise share them and conflict on every change of their value.
+const legacyFieldManager = "manager"
+
+// KongAiGatewayReconciler is the built-in implementation of the AiGatewayClasses with the controller
+// kong.ControllerName. It renders the effective AiGateway into Kong routes and plugins, see package kong, and
+// reports the gateway as Ready once they are applied; Kong itself serves the requests.
+type KongAiGatewayReconciler struct {
+	client.Client
+	Recorder record.EventRecorder
+	// APIReader reads the API key Secrets of the models uncached, so the manager doesn't cache the Secrets of the
+	// cluster.
+	APIReader client.Reader
+
+	// IngressClassName is the ingress class of the Kong ingress controller serving the routes.
+	IngressClassName string
+	// Shard is the shard of the gateways reconciled by this replica, see gatewayv1alpha1.ShardLabel.
+	Shard string
+	// ClassNamespace is the namespace of the AiGatewayClasses, see gatewayv1alpha1.ResolveClass.
+	ClassNamespace string
+}
+
+// Reconcile applies the Kong resources of the AiGateway, prunes those of removed models and updates its Ready
+// condition. Gateways of other classes or shards are ignored without touching their status; the Kong resources of
+// gateways that moved to another class are pruned, those of gateways that moved to another shard are left to the
+// replica of that shard, which applies the same resources. The duration of the reconciliation is exported as
+// aigateway_reconcile_duration_seconds until the gateway is no longer handled.
+func (r *KongAiGatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	start := time.Now()
+	var aiGateway gatewayv1alpha1.AiGateway
+	if err := r.Get(ctx, req.NamespacedName, &aiGateway); err != nil {
+		if apierrors.IsNotFound(err) {
+			gatewayReconcileDuration.DeleteLabelValues(req.Namespace, req.Name)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !gatewayv1alpha1.InShard(aiGateway.Labels, r.Shard) {
+		gatewayReconcileDuration.DeleteLabelValues(req.Namespace, req.Name)
+		return ctrl.Result{}, nil
+	}
+	handled, err := r.handles(ctx, &aiGateway)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !handled {
+		gatewayReconcileDuration.DeleteLabelValues(req.Namespace, req.Name)
+		_, err := r.prune(ctx, &aiGateway, nil)
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		gatewayReconcileDuration.WithLabelValues(req.Namespace, req.Name).Set(time.Since(start).Seconds())
+	}()
+	log := logf.FromContext(ctx).WithValues("generation", aiGateway.Generation)
+
+	effective, err := (&render.Renderer{Client: r.Client}).Effective(ctx, req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	objs, err := kong.Render(effective, r.IngressClassName)
+	if err != nil {
+		return ctrl.Result{}, r.reportConfigError(ctx, &aiGateway, err.Error())
+	}
+	invalid, err := r.checkAPIKeys(ctx, effective)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if invalid != "" {
+		return ctrl.Result{}, r.reportConfigError(ctx, &aiGateway, invalid)
+	}
+
+	changed := false
+	desired := make(map[schema.GroupVersionKind]map[string]bool)
+	for _, obj := range objs {
+		gvk, updated, err := r.apply(ctx, &aiGateway, obj)
+		if apierrors.HasStatusCause(err, metav1.CauseTypeFieldManagerConflict) {
+			r.Recorder.Event(&aiGateway, corev1.EventTypeWarning, KongReasonConflict, err.Error())
+			return ctrl.Result{}, r.updateReady(ctx, &aiGateway, metav1.ConditionFalse, KongReasonConflict,
+				err.Error())
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if desired[gvk] == nil {
+			desired[gvk] = map[string]bool{}
+		}
+		desired[gvk][obj.GetName()] = true
+		changed = changed || updated
+	}
+	pruned, err := r.prune(ctx, &aiGateway, desired)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if changed || pruned {
+		log.Info("Updated Kong resources", "models", len(effective.Spec.AiModels))
+		r.Recorder.Eventf(&aiGateway, corev1.EventTypeNormal, gatewayv1alpha1.EventReasonConfigRendered,
+			"Kong routes and plugins of %d models updated", len(effective.Spec.AiModels))
+	}
+	return ctrl.Result{}, r.updateReady(ctx, &aiGateway, metav1.ConditionTrue, KongReasonProgrammed,
+		fmt.Sprintf("Kong serves the models under %s", kong.PathPrefix(&aiGateway)))
+}
+
+// checkAPIKeys describes the first model whose API key Secret holds a value Kong cannot use, see kong.CheckAPIKey,
+// or returns an empty string if there is none. Missing Secrets are not reported, the AiGateway webhook warns about
+// them.
+func (r *KongAiGatewayReconciler) checkAPIKeys(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (string, error) {
+	for _, model := range aiGateway.Spec.AiModels {
+		ref := model.APIKeySecretRef
+		if ref == nil {
+			continue
+		}
+		var secret corev1.Secret
+		err := r.APIReader.Get(ctx, client.ObjectKey{Namespace: aiGateway.Namespace, Name: ref.Name}, &secret)
+		if apierrors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return "", fmt.Errorf("failed to get Secret %s: %w", ref.Name, err)
+		}
+		if err := kong.CheckAPIKey(model, secret.Data[ref.Key]); err != nil {
+			return fmt.Sprintf("model %q: key %q of Secret %q %v", model.PublicName(), ref.Key, ref.Name, err), nil
+		}
+	}
+	return "", nil
+}
+
+// handles reports whether the class of the gateway, see gatewayv1alpha1.ResolveClass, exists and is handled by the
+// Kong implementation.
+func (r *KongAiGatewayReconciler) handles(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway) (bool, error) {
+	if aiGateway.Spec.AiGatewayClassName == "" {
+		return false, nil
+	}
+	var aiGatewayClasses gatewayv1alpha1.AiGatewayClassList
+	if err := r.List(ctx, &aiGatewayClasses, client.InNamespace(r.ClassNamespace)); err != nil {
+		return false, fmt.Errorf("failed to list AiGatewayClasses: %w", err)
+	}
+	aiGatewayClass := gatewayv1alpha1.ResolveClass(aiGatewayClasses.Items, aiGateway.Spec.AiGatewayClassName,
+		r.ClassNamespace)
+	return aiGatewayClass != nil && aiGatewayClass.HandledBy(kong.ControllerName), nil
+}
+
+// apply server-side applies obj, owned by the gateway, as FieldManager and reports its kind and whether it
+// changed. Applying is not forced: if another field manager owns a rendered field with a different value, apply
+// fails with a conflict, which Reconcile reports in the Ready condition instead of overwriting the field.
+func (r *KongAiGatewayReconciler) apply(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway,
+	obj client.Object) (schema.GroupVersionKind, bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, r.Scheme())
+	if err != nil {
+		return gvk, false, err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return gvk, false, err
+	}
+	desired := &unstructured.Unstructured{Object: map[string]any{}}
+	for key, value := range content {
+		if key != "metadata" && key != "status" {
+			desired.Object[key] = value
+		}
+	}
+	desired.SetGroupVersionKind(gvk)
+	desired.SetName(obj.GetName())
+	desired.SetNamespace(obj.GetNamespace())
+	desired.SetLabels(obj.GetLabels())
+	desired.SetAnnotations(obj.GetAnnotations())
+	if err := controllerutil.SetControllerReference(aiGateway, desired, r.Scheme()); err != nil {
+		return gvk, false, err
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(gvk)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), existing); client.IgnoreNotFound(err) != nil {
+		return gvk, false, fmt.Errorf("failed to get %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, sets.New(legacyFieldManager), FieldManager)
+	if err != nil {
+		return gvk, false, fmt.Errorf("failed to migrate the field managers of %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	if patch != nil {
+		if err := r.Patch(ctx, existing, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+			return gvk, false, fmt.Errorf("failed to migrate the field managers of %s %s: %w",
+				gvk.Kind, obj.GetName(), err)
+		}
+	}
+
+	if err := r.Patch(ctx, desired, client.Apply, client.FieldOwner(FieldManager)); err != nil {
+		return gvk, false, fmt.Errorf("failed to apply %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	return gvk, desired.GetResourceVersion() != existing.GetResourceVersion(), nil
+}
+
+// prune deletes the Ingresses and KongPlugins of the gateway that are no longer rendered, e.g. of removed models,
+// or all of them if desired is nil.
+func (r *KongAiGatewayReconciler) prune(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway,
+	desired map[schema.GroupVersionKind]map[string]bool) (bool, error) {
+	pruned := false
+	for _, gvk := range []schema.GroupVersionKind{
+		networkingv1.SchemeGroupVersion.WithKind("Ingress"), kong.KongPluginGVK,
+	} {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := r.List(ctx, list, client.InNamespace(aiGateway.Namespace),
+			client.MatchingLabels{kong.GatewayLabel: aiGateway.Name}); err != nil {
+			return pruned, fmt.Errorf("failed to list %s: %w", gvk.Kind, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if desired[gvk][obj.GetName()] || !metav1.IsControlledBy(obj, aiGateway) {
+				continue
+			}
+			if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				return pruned, fmt.Errorf("failed to delete %s %s: %w", gvk.Kind, obj.GetName(), err)
+			}
+			pruned = true
+		}
+	}
+	return pruned, nil
+}
+
+// reportConfigError reports a gateway that cannot be served by Kong with a ValidationFailed Event and a Ready
+// condition with the reason of gatewayv1alpha1.ErrorCategoryConfig.
+func (r *KongAiGatewayReconciler) reportConfigError(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway,
+	message string) error {
+	r.Recorder.Event(aiGateway, corev1.EventTypeWarning, gatewayv1alpha1.EventReasonValidationFailed, message)
+	return r.updateReady(ctx, aiGateway, metav1.ConditionFalse, gatewayv1alpha1.ErrorCategoryConfig.Reason(), message)
+}
+
+// updateReady updates the Ready condition and the observed generation of the gateway if they changed.
+func (r *KongAiGatewayReconciler) updateReady(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway,
+	status metav1.ConditionStatus, reason, message string) error {
+	changed := meta.SetStatusCondition(&aiGateway.Status.Conditions, metav1.Condition{
+		Type:               gatewayv1alpha1.AiGatewayConditionReady,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: aiGateway.Generation,
+	})
+	if !changed {
+		return nil
+	}
+	return r.Status().Update(ctx, aiGateway)
+}
+
+// SetupWithManager registers the reconciler in the manager. Gateways of the shard are reconciled when their
+// class changes, via the ClassNameIndexField index, and when one of their Services or Ingresses changes.
+func (r *KongAiGatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gatewayv1alpha1.AiGateway{},
+		gatewayv1alpha1.ClassNameIndexField, func(obj client.Object) []string {
+			return []string{obj.(*gatewayv1alpha1.AiGateway).Spec.AiGatewayClassName}
+		}); err != nil {
+		return err
+	}
+	inShard := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return gatewayv1alpha1.InShard(obj.GetLabels(), r.Shard)
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha1.AiGateway{}, builder.WithPredicates(inShard)).
+		Owns(&corev1.Service{}).
+		Owns(&networkingv1.Ingress{}).
+		Watches(&gatewayv1alpha1.AiGatewayClass{}, handler.EnqueueRequestsFromMapFunc(r.classGateways)).
+		Named("kong-aigateway").
+		Complete(r)
+}
+
+// classGateways returns the requests of the gateways of a class in the shard of the reconciler. Classes outside
+// the class namespace have no gateways.
+func (r *KongAiGatewayReconciler) classGateways(ctx context.Context, obj client.Object) []reconcile.Request {
+	if obj.GetNamespace() != r.ClassNamespace {
+		return nil
+	}
+	var aiGateways gatewayv1alpha1.AiGatewayList
+	if err := r.List(ctx, &aiGateways,
+		client.MatchingFields{gatewayv1alpha1.ClassNameIndexField: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list AiGateways of class", "class", obj.GetName())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(aiGateways.Items))
+	for _, aiGateway := range aiGateways.Items {
+		if !gatewayv1alpha1.InShard(aiGateway.Labels, r.Shard) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&aiGateway)})
+	}
+	return requests
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/kong"
+)
+
+var _ = Describe("Kong AiGateway Controller", func() {
+	var (
+		ctx        context.Context
+		reconciler *KongAiGatewayReconciler
+		// conflicting is the name of a resource whose apply fails with a field manager conflict.
+		conflicting string
+	)
+
+	reconcile := func(name string) *gatewayv1alpha1.AiGateway {
+		key := types.NamespacedName{Namespace: "default", Name: name}
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		var aiGateway gatewayv1alpha1.AiGateway
+		Expect(reconciler.Get(ctx, key, &aiGateway)).To(Succeed())
+		return &aiGateway
+	}
+
+	kongPlugins := func() []string {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(kong.KongPluginGVK.GroupVersion().WithKind("KongPluginList"))
+		Expect(reconciler.List(ctx, list, client.InNamespace("default"))).To(Succeed())
+		var names []string
+		for _, plugin := range list.Items {
+			names = append(names, plugin.GetName())
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		conflicting = ""
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		newGateway := func(name, className string) *gatewayv1alpha1.AiGateway {
+			return &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Generation: 1},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					AiGatewayClassName: className,
+					AiModels: []gatewayv1alpha1.AiModel{
+						{Name: "gpt-4o", Provider: "openai"},
+						{Name: "claude-3-5-sonnet", Provider: "anthropic"},
+					},
+				},
+			}
+		}
+		shardedGateway := newGateway("sharded-gateway", "kong")
+		shardedGateway.Labels = map[string]string{gatewayv1alpha1.ShardLabel: "a"}
+		reconciler = &KongAiGatewayReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(
+					&gatewayv1alpha1.AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "kong", Namespace: "default"},
+						Spec: gatewayv1alpha1.AiGatewayClassSpec{Controller: kong.ControllerName}},
+					&gatewayv1alpha1.AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "litellm", Namespace: "default"},
+						Spec: gatewayv1alpha1.AiGatewayClassSpec{Controller: "litellm.agentic-layer.ai/controller"}},
+					newGateway("kong-gateway", "kong"),
+					newGateway("litellm-gateway", "litellm"),
+					shardedGateway,
+				).
+				WithStatusSubresource(&gatewayv1alpha1.AiGateway{}).
+				WithInterceptorFuncs(interceptor.Funcs{Patch: func(ctx context.Context, c client.WithWatch,
+					obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					if patch.Type() == types.ApplyPatchType && obj.GetName() == conflicting {
+						return apierrors.NewApplyConflict([]metav1.StatusCause{{
+							Type:    metav1.CauseTypeFieldManagerConflict,
+							Message: `conflict with "kubectl-edit" using configuration.konghq.com/v1`,
+							Field:   ".config",
+						}}, `Apply failed with 1 conflict: conflict with "kubectl-edit": .config`)
+					}
+					return applyAsUpdate(ctx, c, obj, patch, opts...)
+				}}).Build(),
+			Recorder:         record.NewFakeRecorder(10),
+			IngressClassName: "kong",
+			ClassNamespace:   "default",
+		}
+		reconciler.APIReader = reconciler.Client
+	})
+
+	It("Should apply the Kong resources of a gateway of a Kong class", func() {
+		aiGateway := reconcile("kong-gateway")
+
+		condition := meta.FindStatusCondition(aiGateway.Status.Conditions, gatewayv1alpha1.AiGatewayConditionReady)
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(KongReasonProgrammed))
+
+		var service corev1.Service
+		Expect(reconciler.Get(ctx, types.NamespacedName{Namespace: "default", Name: "kong-gateway"}, &service)).
+			To(Succeed())
+		Expect(metav1.IsControlledBy(&service, aiGateway)).To(BeTrue())
+		var ingresses networkingv1.IngressList
+		Expect(reconciler.List(ctx, &ingresses)).To(Succeed())
+		Expect(ingresses.Items).To(HaveLen(2))
+		Expect(kongPlugins()).To(ConsistOf("kong-gateway-0", "kong-gateway-1"))
+		Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(HavePrefix("Normal ConfigRendered")))
+
+		By("reconciling again without changes")
+		resourceVersion := reconcile("kong-gateway").ResourceVersion
+		Expect(reconcile("kong-gateway").ResourceVersion).To(Equal(resourceVersion))
+		Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(BeEmpty())
+	})
+
+	It("Should prune the resources of removed models", func() {
+		aiGateway := reconcile("kong-gateway")
+		aiGateway.Spec.AiModels = aiGateway.Spec.AiModels[:1]
+		Expect(reconciler.Update(ctx, aiGateway)).To(Succeed())
+		reconcile("kong-gateway")
+
+		Expect(kongPlugins()).To(ConsistOf("kong-gateway-0"))
+	})
+
+	It("Should report models of unsupported providers", func() {
+		aiGateway := reconcile("kong-gateway")
+		aiGateway.Spec.AiModels = append(aiGateway.Spec.AiModels,
+			gatewayv1alpha1.AiModel{Name: "command-r", Provider: "cohere"})
+		Expect(reconciler.Update(ctx, aiGateway)).To(Succeed())
+
+		condition := meta.FindStatusCondition(reconcile("kong-gateway").Status.Conditions,
+			gatewayv1alpha1.AiGatewayConditionReady)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.ErrorCategoryConfig.Reason()))
+		Expect(condition.Message).To(ContainSubstring(`provider "cohere" is not supported by Kong`))
+	})
+
+	It("Should report API keys without the prefix of the header", func() {
+		Expect(reconciler.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "openai", Namespace: "default"},
+			Data:       map[string][]byte{"raw": []byte(`"sk-123"`), "header": []byte(`"Bearer sk-123"`)},
+		})).To(Succeed())
+		aiGateway := reconcile("kong-gateway")
+		aiGateway.Spec.AiModels[0].APIKeySecretRef = &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "openai"}, Key: "raw"}
+		Expect(reconciler.Update(ctx, aiGateway)).To(Succeed())
+
+		condition := meta.FindStatusCondition(reconcile("kong-gateway").Status.Conditions,
+			gatewayv1alpha1.AiGatewayConditionReady)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.ErrorCategoryConfig.Reason()))
+		Expect(condition.Message).To(Equal(`model "gpt-4o": key "raw" of Secret "openai" must start with ` +
+			`"Bearer ", which Kong does not add to the Authorization header`))
+
+		By("using the key holding the header value")
+		aiGateway = reconcile("kong-gateway")
+		aiGateway.Spec.AiModels[0].APIKeySecretRef.Key = "header"
+		Expect(reconciler.Update(ctx, aiGateway)).To(Succeed())
+		condition = meta.FindStatusCondition(reconcile("kong-gateway").Status.Conditions,
+			gatewayv1alpha1.AiGatewayConditionReady)
+		Expect(condition.Reason).To(Equal(KongReasonProgrammed))
+	})
+
+	It("Should keep fields set by other field managers", func() {
+		reconcile("kong-gateway")
+		var service corev1.Service
+		key := types.NamespacedName{Namespace: "default", Name: "kong-gateway"}
+		Expect(reconciler.Get(ctx, key, &service)).To(Succeed())
+		service.Annotations = map[string]string{"external-dns.alpha.kubernetes.io/hostname": "ai.example.com"}
+		Expect(reconciler.Update(ctx, &service)).To(Succeed())
+
+		reconcile("kong-gateway")
+		Expect(reconciler.Get(ctx, key, &service)).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue("external-dns.alpha.kubernetes.io/hostname", "ai.example.com"))
+	})
+
+	It("Should report conflicts with other field managers", func() {
+		conflicting = "kong-gateway-0"
+		aiGateway := reconcile("kong-gateway")
+
+		condition := meta.FindStatusCondition(aiGateway.Status.Conditions, gatewayv1alpha1.AiGatewayConditionReady)
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(KongReasonConflict))
+		Expect(condition.Message).To(ContainSubstring(`conflict with "kubectl-edit"`))
+		Expect(reconciler.Recorder.(*record.FakeRecorder).Events).To(Receive(HavePrefix("Warning Conflict")))
+	})
+
+	It("Should only reconcile the gateways of its shard", func() {
+		Expect(reconcile("sharded-gateway").Status.Conditions).To(BeEmpty())
+
+		reconciler.Shard = "a"
+		Expect(reconcile("sharded-gateway").Status.Conditions).NotTo(BeEmpty())
+		Expect(reconcile("kong-gateway").Status.Conditions).To(BeEmpty())
+	})
+
+	It("Should ignore gateways of other classes", func() {
+		aiGateway := reconcile("litellm-gateway")
+
+		Expect(aiGateway.Status.Conditions).To(BeEmpty())
+		Expect(kongPlugins()).To(BeEmpty())
+	})
+
+	It("Should prune the resources of gateways that moved to another class", func() {
+		aiGateway := reconcile("kong-gateway")
+		Expect(kongPlugins()).To(ConsistOf("kong-gateway-0", "kong-gateway-1"))
+
+		aiGateway.Spec.AiGatewayClassName = "litellm"
+		Expect(reconciler.Update(ctx, aiGateway)).To(Succeed())
+		reconcile("kong-gateway")
+
+		Expect(kongPlugins()).To(BeEmpty())
+		var ingresses networkingv1.IngressList
+		Expect(reconciler.List(ctx, &ingresses)).To(Succeed())
+		Expect(ingresses.Items).To(BeEmpty())
+	})
+
+	It("Should export the reconcile duration of a gateway until it is deleted", func() {
+		reconcile("kong-gateway")
+		series := testutil.CollectAndCount(gatewayReconcileDuration)
+		Expect(testutil.ToFloat64(gatewayReconcileDuration.WithLabelValues("default", "kong-gateway"))).
+			To(BeNumerically(">", 0))
+
+		Expect(reconciler.Delete(ctx, &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "kong-gateway", Namespace: "default"},
+		})).To(Succeed())
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: "kong-gateway"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testutil.CollectAndCount(gatewayReconcileDuration)).To(Equal(series - 1))
+	})
+})
+
+// applyAsUpdate emulates server-side apply, which the fake client doesn't support, for the unstructured objects
+// applied by the reconciler: it creates the object or merges its top-level fields and metadata into the existing
+// one, keeping the fields it doesn't set, and only updates it if it changed.
+func applyAsUpdate(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch,
+	opts ...client.PatchOption) error {
+	if patch.Type() != types.ApplyPatchType {
+		return c.Patch(ctx, obj, patch, opts...)
+	}
+	desired := obj.(*unstructured.Unstructured)
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	if err := c.Get(ctx, client.ObjectKeyFromObject(desired), existing); apierrors.IsNotFound(err) {
+		return c.Create(ctx, desired)
+	} else if err != nil {
+		return err
+	}
+
+	merged := existing.DeepCopy()
+	for key, value := range desired.Object {
+		if key != "metadata" {
+			merged.Object[key] = value
+		}
+	}
+	merge := func(existing, desired map[string]string) map[string]string {
+		if len(desired) == 0 {
+			return existing
+		}
+		merged := maps.Clone(existing)
+		if merged == nil {
+			merged = map[string]string{}
+		}
+		maps.Copy(merged, desired)
+		return merged
+	}
+	merged.SetLabels(merge(existing.GetLabels(), desired.GetLabels()))
+	merged.SetAnnotations(merge(existing.GetAnnotations(), desired.GetAnnotations()))
+	merged.SetOwnerReferences(desired.GetOwnerReferences())
+	if !equality.Semantic.DeepEqual(merged, existing) {
+		if err := c.Update(ctx, merged); err != nil {
+			return err
+		}
+	}
+	desired.Object = merged.Object
+	return nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/render"
+)
+
+var (
+	// gatewayModels reports the number of models of the effective AiGateways, see render.Renderer.Effective.
+	gatewayModels = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aigateway_models",
+		Help: "Number of AI models of an AiGateway, including those of its AiModelLibraries and baselines.",
+	}, []string{"namespace", "name"})
+
+	// gatewayRolloutAborted reports the AiGateways whose last canary rollout was aborted. It depends on
+	// status.rollout, which is not set by this operator but by implementations running canary rollouts.
+	gatewayRolloutAborted = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aigateway_rollout_aborted",
+		Help: "Whether the last canary rollout of an AiGateway was aborted (1) or not (0).",
+	}, []string{"namespace", "name"})
+
+	// gatewayReconcileDuration reports the duration of the last reconciliation of the AiGateways handled by the
+	// built-in Kong implementation, see KongAiGatewayReconciler.
+	gatewayReconcileDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "aigateway_reconcile_duration_seconds",
+		Help: "Duration of the last reconciliation of an AiGateway by the built-in Kong implementation.",
+	}, []string{"namespace", "name"})
+)
+
+func init() {
+	// Metrics registered with the controller-runtime registry are served from the manager's metrics endpoint.
+	metrics.Registry.MustRegister(gatewayModels, gatewayRolloutAborted, gatewayReconcileDuration)
+}
+
+// GatewayMetricsReconciler exports the aigateway_models and aigateway_rollout_aborted metrics of the AiGateways of
+// its shard, and removes them once a gateway is deleted or leaves the shard. Like all controllers, it only runs on
+// the leader, so a single replica serves the metrics.
+type GatewayMetricsReconciler struct {
+	client.Client
+
+	// Shard is the shard of the gateways exported by this replica, see gatewayv1alpha1.ShardLabel.
+	Shard string
+}
+
+// Reconcile updates the metrics of the AiGateway.
+func (r *GatewayMetricsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var aiGateway gatewayv1alpha1.AiGateway
+	if err := r.Get(ctx, req.NamespacedName, &aiGateway); err != nil {
+		if apierrors.IsNotFound(err) {
+			forgetGatewayMetrics(req.NamespacedName)
+		}
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !gatewayv1alpha1.InShard(aiGateway.Labels, r.Shard) {
+		forgetGatewayMetrics(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+
+	effective, err := (&render.Renderer{Client: r.Client}).Effective(ctx, req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	gatewayModels.WithLabelValues(req.Namespace, req.Name).Set(float64(len(effective.Spec.AiModels)))
+	aborted := 0.0
+	if rollout := aiGateway.Status.Rollout; rollout != nil && rollout.Phase == gatewayv1alpha1.RolloutPhaseAborted {
+		aborted = 1
+	}
+	gatewayRolloutAborted.WithLabelValues(req.Namespace, req.Name).Set(aborted)
+	return ctrl.Result{}, nil
+}
+
+// forgetGatewayMetrics removes the metrics the GatewayMetricsReconciler exports for the gateway.
+func forgetGatewayMetrics(key types.NamespacedName) {
+	gatewayModels.DeleteLabelValues(key.Namespace, key.Name)
+	gatewayRolloutAborted.DeleteLabelValues(key.Namespace, key.Name)
+}
+
+// SetupWithManager registers the reconciler in the manager. All AiGateways are watched, so gateways leaving the
+// shard are forgotten, and gateways are also reconciled when an AiModelLibrary or a baseline AiGateway they
+// reference directly changes.
+func (r *GatewayMetricsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha1.AiGateway{}).
+		Watches(&gatewayv1alpha1.AiGateway{}, handler.EnqueueRequestsFromMapFunc(r.dependentGateways)).
+		Watches(&gatewayv1alpha1.AiModelLibrary{}, handler.EnqueueRequestsFromMapFunc(r.dependentGateways)).
+		Named("aigateway-metrics").
+		Complete(r)
+}
+
+// dependentGateways returns the requests of the gateways of the shard importing or extending obj, an
+// AiModelLibrary or a baseline AiGateway.
+func (r *GatewayMetricsReconciler) dependentGateways(ctx context.Context, obj client.Object) []reconcile.Request {
+	var aiGateways gatewayv1alpha1.AiGatewayList
+	if err := r.List(ctx, &aiGateways,
+		client.MatchingLabelsSelector{Selector: gatewayv1alpha1.ShardSelector(r.Shard)}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list AiGateways")
+		return nil
+	}
+	_, isLibrary := obj.(*gatewayv1alpha1.AiModelLibrary)
+	var requests []reconcile.Request
+	for _, aiGateway := range aiGateways.Items {
+		if dependsOn(&aiGateway, obj, isLibrary) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&aiGateway)})
+		}
+	}
+	return requests
+}
+
+// dependsOn reports whether the gateway imports or extends obj, an AiModelLibrary if isLibrary and an AiGateway
+// otherwise.
+func dependsOn(aiGateway *gatewayv1alpha1.AiGateway, obj client.Object, isLibrary bool) bool {
+	ref := aiGateway.Spec.Extends
+	if isLibrary {
+		for _, library := range aiGateway.Spec.ModelLibraries {
+			if library.Name == obj.GetName() {
+				return true
+			}
+		}
+		return ref != nil && ref.Kind == gatewayv1alpha1.ExtendsKindAiModelLibrary && ref.Name == obj.GetName()
+	}
+	if ref == nil || ref.Kind == gatewayv1alpha1.ExtendsKindAiModelLibrary || ref.Name != obj.GetName() {
+		return false
+	}
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = aiGateway.Namespace
+	}
+	return namespace == obj.GetNamespace()
+}
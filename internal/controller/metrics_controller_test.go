@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiGateway Metrics Controller", func() {
+	var (
+		ctx        context.Context
+		reconciler *GatewayMetricsReconciler
+		library    *gatewayv1alpha1.AiModelLibrary
+		platform   *gatewayv1alpha1.AiGateway
+	)
+
+	key := types.NamespacedName{Namespace: "team-a", Name: "gateway"}
+
+	reconcileGateway := func() {
+		_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		library = &gatewayv1alpha1.AiModelLibrary{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+			Spec: gatewayv1alpha1.AiModelLibrarySpec{Models: []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "claude-3-5-sonnet", Provider: "anthropic"},
+			}},
+		}
+		platform = &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "platform",
+				Annotations: map[string]string{gatewayv1alpha1.ExtendableFromAnnotation: "*"}},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				AiModels: []gatewayv1alpha1.AiModel{{Name: "mistral-large", Provider: "mistral"}},
+			},
+		}
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				ModelLibraries: []gatewayv1alpha1.ModelLibraryImport{{Name: "shared"}},
+				Extends: &gatewayv1alpha1.ExtendsRef{
+					Kind: gatewayv1alpha1.ExtendsKindAiGateway, Name: "platform", Namespace: "platform",
+				},
+				AiModels: []gatewayv1alpha1.AiModel{{Name: "llama-3", Provider: "ollama"}},
+			},
+			Status: gatewayv1alpha1.AiGatewayStatus{
+				Rollout: &gatewayv1alpha1.RolloutStatus{Phase: gatewayv1alpha1.RolloutPhaseAborted},
+			},
+		}
+		reconciler = &GatewayMetricsReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(library, platform, aiGateway).
+				WithStatusSubresource(&gatewayv1alpha1.AiGateway{}).Build(),
+		}
+	})
+
+	AfterEach(func() {
+		forgetGatewayMetrics(key)
+	})
+
+	It("Should export the models of libraries and baselines and aborted rollouts", func() {
+		reconcileGateway()
+
+		Expect(testutil.ToFloat64(gatewayModels.WithLabelValues(key.Namespace, key.Name))).To(Equal(4.0))
+		Expect(testutil.ToFloat64(gatewayRolloutAborted.WithLabelValues(key.Namespace, key.Name))).To(Equal(1.0))
+	})
+
+	It("Should remove the metrics of deleted gateways", func() {
+		reconcileGateway()
+		series := testutil.CollectAndCount(gatewayModels)
+
+		Expect(reconciler.Delete(ctx, &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+		})).To(Succeed())
+		reconcileGateway()
+		Expect(testutil.CollectAndCount(gatewayModels)).To(Equal(series - 1))
+	})
+
+	It("Should only export the gateways of its shard", func() {
+		reconcileGateway()
+		series := testutil.CollectAndCount(gatewayModels)
+
+		reconciler.Shard = "a"
+		reconcileGateway()
+		Expect(testutil.CollectAndCount(gatewayModels)).To(Equal(series - 1))
+	})
+
+	It("Should reconcile the gateways importing or extending a changed resource", func() {
+		requests := []reconcile.Request{{NamespacedName: key}}
+		Expect(reconciler.dependentGateways(ctx, library)).To(Equal(requests))
+		Expect(reconciler.dependentGateways(ctx, platform)).To(Equal(requests))
+		Expect(reconciler.dependentGateways(ctx, &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: key.Namespace},
+		})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/providers"
+)
+
+// ModelCatalogSync periodically lists the models of the configured providers and reports models of AiGateways
+// their provider no longer offers via the ProviderModelsAvailable condition and Warning Events, and new provider
+// models via Normal Events, so teams can react to provider sunsets. It runs as a manager Runnable, on the leader
+// of the shard only, as the provider catalogs don't change with the AiGateways.
+type ModelCatalogSync struct {
+	client.Client
+	Recorder record.EventRecorder
+
+	// Providers are the model listers of the synced providers, by provider.
+	Providers map[string]providers.ModelLister
+	// Interval is the time between two syncs.
+	Interval time.Duration
+	// Shard is the shard of the gateways synced by this replica, see gatewayv1alpha1.ShardLabel.
+	Shard string
+
+	// known are the models of the providers at the last sync, to detect new models.
+	known map[string]map[string]bool
+}
+
+// Start syncs the provider catalogs every Interval until the context is cancelled.
+func (s *ModelCatalogSync) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		if err := s.Sync(ctx); err != nil {
+			logf.FromContext(ctx).Error(err, "Failed to sync provider model catalogs")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sync lists the models of all providers and updates the AiGateways of the shard using them. Providers whose
+// models cannot be listed are skipped until the next sync, so an outage of a provider API doesn't mark its models
+// unavailable. Gateways whose status cannot be updated are logged and retried at the next sync, so they don't block
+// the other gateways.
+func (s *ModelCatalogSync) Sync(ctx context.Context) error {
+	log := logf.FromContext(ctx)
+	catalogs := map[string]map[string]bool{}
+	newModels := map[string][]string{}
+	for provider, lister := range s.Providers {
+		models, err := lister.ListModels(ctx)
+		if err != nil {
+			log.Error(err, "Failed to list provider models", "provider", provider)
+			continue
+		}
+		catalog := make(map[string]bool, len(models))
+		for _, model := range models {
+			catalog[model] = true
+			if known, ok := s.known[provider]; ok && !known[model] {
+				newModels[provider] = append(newModels[provider], model)
+			}
+		}
+		catalogs[provider] = catalog
+	}
+	if s.known == nil {
+		s.known = map[string]map[string]bool{}
+	}
+	for provider, catalog := range catalogs {
+		s.known[provider] = catalog
+	}
+
+	var aiGateways gatewayv1alpha1.AiGatewayList
+	if err := s.List(ctx, &aiGateways,
+		client.MatchingLabelsSelector{Selector: gatewayv1alpha1.ShardSelector(s.Shard)}); err != nil {
+		return fmt.Errorf("failed to list AiGateways: %w", err)
+	}
+	for i := range aiGateways.Items {
+		if err := s.syncGateway(ctx, &aiGateways.Items[i], catalogs, newModels); err != nil {
+			log.Error(err, "Failed to sync AiGateway")
+		}
+	}
+	return nil
+}
+
+// syncGateway records the new models of the providers of the gateway and updates its ProviderModelsAvailable
+// condition. The unavailable models are only recorded as an Event when the condition changes.
+func (s *ModelCatalogSync) syncGateway(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway,
+	catalogs map[string]map[string]bool, newModels map[string][]string) error {
+	var unavailable []string
+	providers := map[string]bool{}
+	for _, model := range aiGateway.Spec.AiModels {
+		catalog, ok := catalogs[model.Provider]
+		if !ok {
+			continue
+		}
+		if !providers[model.Provider] && len(newModels[model.Provider]) > 0 {
+			s.Recorder.Eventf(aiGateway, corev1.EventTypeNormal, gatewayv1alpha1.EventReasonNewModelsAvailable,
+				"Provider %s offers new models: %s", model.Provider, strings.Join(newModels[model.Provider], ", "))
+		}
+		providers[model.Provider] = true
+		if !model.IsWildcard() && !offers(catalog, model.ProviderModelName()) {
+			unavailable = append(unavailable, model.QualifiedName())
+		}
+	}
+	if len(providers) == 0 {
+		return nil
+	}
+
+	condition := metav1.Condition{
+		Type:               gatewayv1alpha1.AiGatewayConditionProviderModelsAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             gatewayv1alpha1.AiGatewayReasonModelsAvailable,
+		Message:            "All models are offered by their provider",
+		ObservedGeneration: aiGateway.Generation,
+	}
+	if len(unavailable) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = gatewayv1alpha1.AiGatewayReasonModelsUnavailable
+		condition.Message = "Models no longer offered by their provider: " + strings.Join(unavailable, ", ")
+	}
+	original := aiGateway.DeepCopy()
+	if !meta.SetStatusCondition(&aiGateway.Status.Conditions, condition) {
+		return nil
+	}
+	if len(unavailable) > 0 {
+		s.Recorder.Event(aiGateway, corev1.EventTypeWarning, gatewayv1alpha1.EventReasonModelUnavailable,
+			condition.Message)
+	}
+	logf.FromContext(ctx).Info("Updating provider models condition", "namespace", aiGateway.Namespace,
+		"name", aiGateway.Name, "generation", aiGateway.Generation, "unavailable", unavailable)
+	if err := s.Status().Patch(ctx, aiGateway, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to update status of AiGateway %s/%s: %w", aiGateway.Namespace, aiGateway.Name, err)
+	}
+	return nil
+}
+
+// offers reports whether the catalog contains the model. Providers list pinned versions only, so aliases like
+// "claude-3-5-sonnet-latest" are offered as long as a version of the model is.
+func offers(catalog map[string]bool, model string) bool {
+	if catalog[model] {
+		return true
+	}
+	base, isAlias := strings.CutSuffix(model, "-latest")
+	if !isAlias {
+		return false
+	}
+	for id := range catalog {
+		if strings.HasPrefix(id, base+"-") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/providers"
+)
+
+// staticModelLister lists a fixed set of models.
+type staticModelLister []string
+
+func (l *staticModelLister) ListModels(context.Context) ([]string, error) {
+	return *l, nil
+}
+
+var _ = Describe("Provider model catalog sync", func() {
+	var (
+		ctx      context.Context
+		recorder *record.FakeRecorder
+		openai   *staticModelLister
+		sync     *ModelCatalogSync
+	)
+
+	gatewayCondition := func() *metav1.Condition {
+		var aiGateway gatewayv1alpha1.AiGateway
+		Expect(sync.Get(ctx, types.NamespacedName{Namespace: "default", Name: "gateway"}, &aiGateway)).To(Succeed())
+		return meta.FindStatusCondition(aiGateway.Status.Conditions,
+			gatewayv1alpha1.AiGatewayConditionProviderModelsAvailable)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default", Generation: 1},
+			Spec: gatewayv1alpha1.AiGatewaySpec{AiModels: []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "openai/gpt-4-32k", Provider: "openai"},
+				{Name: "gpt-*", Provider: "openai"},
+				{Name: "claude-3-opus", Provider: "anthropic"},
+			}},
+		}
+		recorder = record.NewFakeRecorder(10)
+		openai = &staticModelLister{"gpt-4o", "gpt-4-32k"}
+		sync = &ModelCatalogSync{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(aiGateway).
+				WithStatusSubresource(&gatewayv1alpha1.AiGateway{}).Build(),
+			Recorder:  recorder,
+			Providers: map[string]providers.ModelLister{"openai": openai},
+		}
+	})
+
+	It("Should report the models as available", func() {
+		Expect(sync.Sync(ctx)).To(Succeed())
+
+		condition := gatewayCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("Should report models the provider no longer offers once", func() {
+		*openai = staticModelLister{"gpt-4o"}
+		Expect(sync.Sync(ctx)).To(Succeed())
+		Expect(sync.Sync(ctx)).To(Succeed())
+
+		condition := gatewayCondition()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Reason).To(Equal(gatewayv1alpha1.AiGatewayReasonModelsUnavailable))
+		Expect(condition.Message).To(ContainSubstring("openai/gpt-4-32k"))
+		Expect(condition.Message).NotTo(ContainSubstring("anthropic"))
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(HavePrefix("Warning ModelUnavailable"))
+	})
+
+	It("Should record new provider models", func() {
+		Expect(sync.Sync(ctx)).To(Succeed())
+		*openai = append(*openai, "gpt-5")
+		Expect(sync.Sync(ctx)).To(Succeed())
+
+		Expect(recorder.Events).To(HaveLen(1))
+		Expect(<-recorder.Events).To(Equal("Normal NewModelsAvailable Provider openai offers new models: gpt-5"))
+	})
+
+	It("Should sync the other gateways if the status of one cannot be updated", func() {
+		var aiGateway gatewayv1alpha1.AiGateway
+		Expect(sync.Get(ctx, types.NamespacedName{Namespace: "default", Name: "gateway"}, &aiGateway)).To(Succeed())
+		broken := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken", Namespace: "default"},
+			Spec:       *aiGateway.Spec.DeepCopy(),
+		}
+		sync.Client = fake.NewClientBuilder().WithScheme(sync.Scheme()).WithObjects(broken, &aiGateway).
+			WithStatusSubresource(&gatewayv1alpha1.AiGateway{}).
+			WithInterceptorFuncs(interceptor.Funcs{SubResourcePatch: func(ctx context.Context, c client.Client,
+				subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				if obj.GetName() == broken.Name {
+					return apierrors.NewConflict(schema.GroupResource{}, obj.GetName(), nil)
+				}
+				return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			}}).Build()
+
+		Expect(sync.Sync(ctx)).To(Succeed())
+		Expect(gatewayCondition()).NotTo(BeNil())
+	})
+
+	It("Should only sync the gateways of its shard", func() {
+		sync.Shard = "a"
+		Expect(sync.Sync(ctx)).To(Succeed())
+
+		Expect(gatewayCondition()).To(BeNil())
+	})
+
+	It("Should treat -latest aliases as offered while a version is", func() {
+		catalog := map[string]bool{"claude-3-5-sonnet-20241022": true}
+		Expect(offers(catalog, "claude-3-5-sonnet-latest")).To(BeTrue())
+		Expect(offers(catalog, "claude-3-opus-latest")).To(BeFalse())
+		Expect(offers(catalog, "claude-3-5-sonnet")).To(BeFalse())
+	})
+})
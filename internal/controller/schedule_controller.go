@@ -0,0 +1,89 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// ScheduleReconciler applies the scaling schedules of the AiGateways of its shard. It reports the replicas of the
+// open schedule window in status.scheduledReplicas and requeues the gateway at the next window boundary, so the
+// status changes when a window opens or closes and the implementations watching the gateway scale it.
+type ScheduleReconciler struct {
+	client.Client
+
+	// Shard is the shard of the gateways scheduled by this replica, see gatewayv1alpha1.ShardLabel.
+	Shard string
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Reconcile updates status.scheduledReplicas of the AiGateway and requeues it at the next window boundary.
+func (r *ScheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var aiGateway gatewayv1alpha1.AiGateway
+	if err := r.Get(ctx, req.NamespacedName, &aiGateway); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !gatewayv1alpha1.InShard(aiGateway.Labels, r.Shard) {
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	if r.Now != nil {
+		now = r.Now()
+	}
+	replicas, boundary, err := aiGateway.Spec.ScheduledReplicas(now)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to evaluate the schedules of AiGateway %s/%s: %w",
+			aiGateway.Namespace, aiGateway.Name, err)
+	}
+
+	if !ptr.Equal(aiGateway.Status.ScheduledReplicas, replicas) {
+		original := aiGateway.DeepCopy()
+		aiGateway.Status.ScheduledReplicas = replicas
+		logf.FromContext(ctx).Info("Updating scheduled replicas", "namespace", aiGateway.Namespace,
+			"name", aiGateway.Name, "replicas", replicas)
+		if err := r.Status().Patch(ctx, &aiGateway, client.MergeFrom(original)); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to update status of AiGateway %s/%s: %w",
+				aiGateway.Namespace, aiGateway.Name, err)
+		}
+	}
+
+	if boundary.IsZero() {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{RequeueAfter: boundary.Sub(now)}, nil
+}
+
+// SetupWithManager registers the reconciler in the manager. All AiGateways are watched, so that
+// status.scheduledReplicas is also cleared once the schedules of a gateway are removed.
+func (r *ScheduleReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&gatewayv1alpha1.AiGateway{}).
+		Named("aigateway-schedules").
+		Complete(r)
+}
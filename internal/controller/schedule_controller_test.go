@@ -0,0 +1,115 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiGateway Schedule Controller", func() {
+	var (
+		ctx        context.Context
+		reconciler *ScheduleReconciler
+		now        time.Time
+	)
+
+	key := types.NamespacedName{Namespace: "default", Name: "gateway"}
+
+	reconcileGateway := func() (ctrl.Result, *gatewayv1alpha1.AiGateway) {
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		var aiGateway gatewayv1alpha1.AiGateway
+		Expect(reconciler.Get(ctx, key, &aiGateway)).To(Succeed())
+		return result, &aiGateway
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		// A Monday.
+		now = time.Date(2025, time.March, 3, 7, 30, 0, 0, time.UTC)
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				Schedules: []gatewayv1alpha1.ScalingSchedule{{
+					Name:     "business-hours",
+					Start:    "0 8 * * 1-5",
+					Duration: metav1.Duration{Duration: 10 * time.Hour},
+					Replicas: 3,
+				}},
+			},
+		}
+		reconciler = &ScheduleReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(aiGateway).
+				WithStatusSubresource(&gatewayv1alpha1.AiGateway{}).Build(),
+			Now: func() time.Time { return now },
+		}
+	})
+
+	It("Should report the replicas of the open window and requeue at the window boundaries", func() {
+		result, aiGateway := reconcileGateway()
+		Expect(aiGateway.Status.ScheduledReplicas).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Minute))
+
+		By("opening the window")
+		now = now.Add(result.RequeueAfter)
+		result, aiGateway = reconcileGateway()
+		Expect(aiGateway.Status.ScheduledReplicas).To(Equal(ptr.To[int32](3)))
+		Expect(result.RequeueAfter).To(Equal(10 * time.Hour))
+
+		By("closing the window")
+		now = now.Add(result.RequeueAfter)
+		result, aiGateway = reconcileGateway()
+		Expect(aiGateway.Status.ScheduledReplicas).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(14 * time.Hour))
+	})
+
+	It("Should clear the scheduled replicas once the schedules are removed", func() {
+		now = now.Add(time.Hour)
+		_, aiGateway := reconcileGateway()
+		Expect(aiGateway.Status.ScheduledReplicas).To(Equal(ptr.To[int32](3)))
+
+		aiGateway.Spec.Schedules = nil
+		Expect(reconciler.Update(ctx, aiGateway)).To(Succeed())
+		result, aiGateway := reconcileGateway()
+		Expect(aiGateway.Status.ScheduledReplicas).To(BeNil())
+		Expect(result.RequeueAfter).To(BeZero())
+	})
+
+	It("Should only schedule the gateways of its shard", func() {
+		now = now.Add(time.Hour)
+		reconciler.Shard = "a"
+		result, aiGateway := reconcileGateway()
+		Expect(aiGateway.Status.ScheduledReplicas).To(BeNil())
+		Expect(result.RequeueAfter).To(BeZero())
+	})
+})
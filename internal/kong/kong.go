@@ -0,0 +1,258 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kong renders AiGateways into the resources of an existing Kong install, for the built-in implementation
+// of AiGatewayClasses with the controller ControllerName. Every model becomes an Ingress route of the Kong
+// ingress class with an ai-proxy KongPlugin forwarding chat requests to its provider. Only the models of a gateway
+// are rendered; features requiring a LiteLLM data plane, e.g. guardrails or rate limit policies, are ignored.
+package kong
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// ControllerName is the spec.controller of the AiGatewayClasses handled by the Kong implementation.
+const ControllerName = "kong.agentic-layer.ai"
+
+// GatewayLabel is set to the name of the AiGateway on all resources rendered for it, to prune the resources of
+// removed models.
+const GatewayLabel = "agentic-layer.ai/aigateway"
+
+// anthropicVersion is the version of the Anthropic API requested by ai-proxy.
+const anthropicVersion = "2023-06-01"
+
+// KongPluginGVK is the kind of the plugins configured on the routes of the models.
+var KongPluginGVK = schema.GroupVersionKind{Group: "configuration.konghq.com", Version: "v1", Kind: "KongPlugin"}
+
+// providerAuth is the ai-proxy authentication of a provider: the header or query parameter carrying the API key,
+// and the prefix its value must start with, which Kong does not add, see CheckAPIKey.
+type providerAuth struct {
+	header, param, prefix string
+}
+
+// kongProviders maps the providers of AiModels to the ai-proxy provider and the authentication it expects.
+var kongProviders = map[string]struct {
+	provider string
+	auth     providerAuth
+}{
+	"openai":    {"openai", providerAuth{header: "Authorization", prefix: "Bearer "}},
+	"mistral":   {"mistral", providerAuth{header: "Authorization", prefix: "Bearer "}},
+	"anthropic": {"anthropic", providerAuth{header: "x-api-key"}},
+	"azure":     {"azure", providerAuth{header: "api-key"}},
+	"gemini":    {"gemini", providerAuth{param: "key"}},
+	"vertex_ai": {"gemini", providerAuth{}},
+	"bedrock":   {"bedrock", providerAuth{}},
+}
+
+// PathPrefix returns the path under which the Kong proxy serves the models of the gateway. Clients request a
+// model at the prefix followed by its public name, e.g. "/default/my-gateway/gpt-4o/v1/chat/completions".
+func PathPrefix(g *gatewayv1alpha1.AiGateway) string {
+	return "/" + g.Namespace + "/" + g.Name
+}
+
+// Render returns the resources of the effective AiGateway: a placeholder Service the routes point to, whose
+// upstream ai-proxy replaces, and an Ingress of the given ingress class and a KongPlugin per model.
+func Render(g *gatewayv1alpha1.AiGateway, ingressClassName string) ([]client.Object, error) {
+	labels := map[string]string{GatewayLabel: g.Name}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: g.Name, Namespace: g.Namespace, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Type:         corev1.ServiceTypeExternalName,
+			ExternalName: "localhost",
+			Ports:        []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+	}
+	objs := []client.Object{service}
+
+	for i, model := range g.Spec.AiModels {
+		config, patches, err := PluginConfig(g.Namespace, model)
+		if err != nil {
+			return nil, fmt.Errorf("model %q: %w", model.PublicName(), err)
+		}
+		name := fmt.Sprintf("%s-%d", g.Name, i)
+
+		plugin := &unstructured.Unstructured{Object: map[string]any{"plugin": "ai-proxy", "config": config}}
+		if len(patches) > 0 {
+			plugin.Object["configPatches"] = patches
+		}
+		plugin.SetGroupVersionKind(KongPluginGVK)
+		plugin.SetName(name)
+		plugin.SetNamespace(g.Namespace)
+		plugin.SetLabels(labels)
+
+		pathType := networkingv1.PathTypePrefix
+		ingress := &networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: g.Namespace,
+				Labels:    labels,
+				Annotations: map[string]string{
+					"konghq.com/plugins":    name,
+					"konghq.com/strip-path": "true",
+				},
+			},
+			Spec: networkingv1.IngressSpec{
+				IngressClassName: &ingressClassName,
+				Rules: []networkingv1.IngressRule{{IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{Paths: []networkingv1.HTTPIngressPath{{
+						Path:     PathPrefix(g) + "/" + model.PublicName(),
+						PathType: &pathType,
+						Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+							Name: service.Name,
+							Port: networkingv1.ServiceBackendPort{Name: "http"},
+						}},
+					}}},
+				}}},
+			},
+		}
+		objs = append(objs, plugin, ingress)
+	}
+	return objs, nil
+}
+
+// PluginConfig returns the configuration of the ai-proxy plugin of the model and the configPatches reading its
+// API key from the Secret referenced by apiKeySecretRef. The Secret key must hold the header or parameter value
+// as a JSON string, e.g. "\"Bearer sk-...\"", as Kong parses patched values as JSON. Models with an apiBase or
+// backendRef are forwarded as OpenAI-compatible models, backendRefs default to the namespace of the gateway.
+// Wildcard models let clients choose the model.
+func PluginConfig(namespace string, model gatewayv1alpha1.AiModel) (map[string]any, []any, error) {
+	kongProvider, ok := kongProviders[model.Provider]
+	options := map[string]any{}
+	switch {
+	case model.APIBase != "" || model.BackendRef != nil:
+		kongProvider.provider = "openai"
+		options["upstream_url"] = strings.TrimSuffix(apiBase(namespace, model), "/") + "/chat/completions"
+	case !ok:
+		return nil, nil, fmt.Errorf("provider %q is not supported by Kong", model.Provider)
+	case model.Azure != nil:
+		base, err := url.Parse(model.Azure.APIBase)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid azure.apiBase: %w", err)
+		}
+		deployment := model.Azure.DeploymentName
+		if deployment == "" {
+			deployment = model.ProviderModelName()
+		}
+		options["azure_instance"] = strings.Split(base.Hostname(), ".")[0]
+		options["azure_deployment_id"] = deployment
+		options["azure_api_version"] = model.Azure.APIVersion
+	case model.VertexAI != nil:
+		options["gemini"] = map[string]any{
+			"api_endpoint": model.VertexAI.Location + "-aiplatform.googleapis.com",
+			"project_id":   model.VertexAI.Project,
+			"location_id":  model.VertexAI.Location,
+		}
+	case model.Bedrock != nil:
+		options["bedrock"] = map[string]any{"aws_region": model.Bedrock.Region}
+	case model.Provider == "anthropic":
+		options["anthropic_version"] = anthropicVersion
+	}
+
+	modelConfig := map[string]any{"provider": kongProvider.provider, "options": options}
+	if !model.IsWildcard() {
+		modelConfig["name"] = model.ProviderModelName()
+	}
+	config := map[string]any{"route_type": "llm/v1/chat", "model": modelConfig}
+
+	var patches []any
+	auth := map[string]any{}
+	keyAuth := modelAuth(model)
+	switch {
+	case usesServiceAccount(model):
+		auth["gcp_use_service_account"] = true
+		patches = append(patches, configPatch("/auth/gcp_service_account_json", model.VertexAI.CredentialsSecretRef))
+	case model.APIKeySecretRef != nil && keyAuth.header != "":
+		auth["header_name"] = keyAuth.header
+		patches = append(patches, configPatch("/auth/header_value", model.APIKeySecretRef))
+	case model.APIKeySecretRef != nil && keyAuth.param != "":
+		auth["param_name"] = keyAuth.param
+		auth["param_location"] = "query"
+		patches = append(patches, configPatch("/auth/param_value", model.APIKeySecretRef))
+	}
+	if len(auth) > 0 {
+		config["auth"] = auth
+	}
+	return config, patches, nil
+}
+
+// CheckAPIKey checks the value of the apiKeySecretRef of the model. configPatches copy it verbatim into the header
+// or query parameter of the ai-proxy plugin, so it must be a JSON string, which Kong parses, and start with the
+// prefix of the header, e.g. "Bearer " for OpenAI, which Kong does not add. A raw API key, as LiteLLM expects it,
+// would otherwise be sent without the prefix and rejected by the provider. Values Kong doesn't use are not checked.
+func CheckAPIKey(model gatewayv1alpha1.AiModel, value []byte) error {
+	auth := modelAuth(model)
+	if usesServiceAccount(model) || (auth.header == "" && auth.param == "") {
+		return nil
+	}
+	var apiKey string
+	if err := json.Unmarshal(value, &apiKey); err != nil {
+		return fmt.Errorf("must hold the API key as a JSON string, e.g. %q", `"`+auth.prefix+`sk-..."`)
+	}
+	if !strings.HasPrefix(apiKey, auth.prefix) {
+		return fmt.Errorf("must start with %q, which Kong does not add to the %s header", auth.prefix, auth.header)
+	}
+	return nil
+}
+
+// modelAuth returns the ai-proxy authentication of the model. Models with an apiBase or backendRef of providers
+// Kong doesn't know authenticate like OpenAI.
+func modelAuth(model gatewayv1alpha1.AiModel) providerAuth {
+	kongProvider, ok := kongProviders[model.Provider]
+	if !ok && (model.APIBase != "" || model.BackendRef != nil) {
+		return kongProviders["openai"].auth
+	}
+	return kongProvider.auth
+}
+
+// usesServiceAccount reports whether the model authenticates with Vertex AI credentials instead of an API key.
+func usesServiceAccount(model gatewayv1alpha1.AiModel) bool {
+	return model.VertexAI != nil && model.VertexAI.CredentialsSecretRef != nil
+}
+
+// apiBase returns the API base of an OpenAI-compatible model, resolving its backendRef into the URL of the
+// Service, which Kong reaches from its own namespace.
+func apiBase(namespace string, model gatewayv1alpha1.AiModel) string {
+	ref := model.BackendRef
+	if ref == nil {
+		return model.APIBase
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	return fmt.Sprintf("http://%s.%s.svc:%d%s", ref.Name, namespace, ref.Port, ref.Path)
+}
+
+// configPatch returns a configPatch setting the value at path from the Secret key.
+func configPatch(path string, ref *corev1.SecretKeySelector) map[string]any {
+	return map[string]any{
+		"path": path,
+		"valueFrom": map[string]any{
+			"secretKeyRef": map[string]any{"name": ref.Name, "key": ref.Key},
+		},
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kong
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Kong rendering", func() {
+	secretKeyRef := func(name, key string) *corev1.SecretKeySelector {
+		return &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: name}, Key: key}
+	}
+	nested := func(obj *unstructured.Unstructured, fields ...string) any {
+		value, _, _ := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+		return value
+	}
+
+	It("Should render a route and an ai-proxy plugin per model", func() {
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "team-a"},
+			Spec: gatewayv1alpha1.AiGatewaySpec{AiModels: []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai", APIKeySecretRef: secretKeyRef("openai", "header")},
+				{Name: "claude-*", Provider: "anthropic", Alias: "claude"},
+			}},
+		}
+
+		objs, err := Render(aiGateway, "kong")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs).To(HaveLen(5))
+
+		service := objs[0].(*corev1.Service)
+		Expect(service.Name).To(Equal("gateway"))
+		Expect(service.Spec.Type).To(Equal(corev1.ServiceTypeExternalName))
+
+		plugin := objs[1].(*unstructured.Unstructured)
+		Expect(plugin.GroupVersionKind()).To(Equal(KongPluginGVK))
+		Expect(plugin.GetName()).To(Equal("gateway-0"))
+		Expect(plugin.GetLabels()).To(HaveKeyWithValue(GatewayLabel, "gateway"))
+		Expect(plugin.Object["plugin"]).To(Equal("ai-proxy"))
+		Expect(nested(plugin, "config", "model", "name")).To(Equal("gpt-4o"))
+		Expect(nested(plugin, "config", "auth", "header_name")).To(Equal("Authorization"))
+		Expect(plugin.Object["configPatches"]).To(ConsistOf(map[string]any{
+			"path":      "/auth/header_value",
+			"valueFrom": map[string]any{"secretKeyRef": map[string]any{"name": "openai", "key": "header"}},
+		}))
+
+		ingress := objs[2].(*networkingv1.Ingress)
+		Expect(ingress.Annotations).To(HaveKeyWithValue("konghq.com/plugins", "gateway-0"))
+		Expect(*ingress.Spec.IngressClassName).To(Equal("kong"))
+		Expect(ingress.Spec.Rules[0].HTTP.Paths[0].Path).To(Equal("/team-a/gateway/gpt-4o"))
+
+		By("letting clients choose the model of wildcard models")
+		wildcard := objs[3].(*unstructured.Unstructured)
+		Expect(nested(wildcard, "config", "model")).NotTo(HaveKey("name"))
+		Expect(nested(wildcard, "config", "model", "options", "anthropic_version")).To(Equal(anthropicVersion))
+		Expect(objs[4].(*networkingv1.Ingress).Spec.Rules[0].HTTP.Paths[0].Path).To(Equal("/team-a/gateway/claude"))
+	})
+
+	It("Should configure the provider options", func() {
+		config, _, err := PluginConfig("default", gatewayv1alpha1.AiModel{
+			Name: "gpt-4o", Provider: "azure",
+			Azure: &gatewayv1alpha1.AzureConfig{APIBase: "https://my-resource.openai.azure.com",
+				APIVersion: "2024-06-01", DeploymentName: "gpt-4o-eu"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config["model"]).To(HaveKeyWithValue("options", map[string]any{
+			"azure_instance": "my-resource", "azure_deployment_id": "gpt-4o-eu", "azure_api_version": "2024-06-01",
+		}))
+
+		config, patches, err := PluginConfig("default", gatewayv1alpha1.AiModel{
+			Name: "gemini-1.5-pro", Provider: "vertex_ai",
+			VertexAI: &gatewayv1alpha1.VertexAIConfig{Project: "my-project", Location: "europe-west4",
+				CredentialsSecretRef: secretKeyRef("gcp", "key.json")},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config["model"]).To(HaveKeyWithValue("provider", "gemini"))
+		Expect(config["auth"]).To(HaveKeyWithValue("gcp_use_service_account", true))
+		Expect(patches).To(HaveLen(1))
+	})
+
+	It("Should forward models with a backend as OpenAI-compatible models", func() {
+		config, _, err := PluginConfig("default", gatewayv1alpha1.AiModel{
+			Name: "llama3", Provider: "ollama",
+			BackendRef: &gatewayv1alpha1.BackendServiceRef{Name: "ollama", Port: 11434, Path: "/v1"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config["model"]).To(HaveKeyWithValue("provider", "openai"))
+		Expect(config["model"]).To(HaveKeyWithValue("options",
+			map[string]any{"upstream_url": "http://ollama.default.svc:11434/v1/chat/completions"}))
+	})
+
+	It("Should check that API keys hold the header value Kong sends", func() {
+		openai := gatewayv1alpha1.AiModel{Name: "gpt-4o", Provider: "openai", APIKeySecretRef: secretKeyRef("openai", "key")}
+		Expect(CheckAPIKey(openai, []byte(`"Bearer sk-123"`))).To(Succeed())
+		Expect(CheckAPIKey(openai, []byte("sk-123"))).To(
+			MatchError(`must hold the API key as a JSON string, e.g. "\"Bearer sk-...\""`))
+		Expect(CheckAPIKey(openai, []byte(`"sk-123"`))).To(
+			MatchError(`must start with "Bearer ", which Kong does not add to the Authorization header`))
+
+		By("requiring the prefix of OpenAI-compatible models")
+		ollama := gatewayv1alpha1.AiModel{Name: "llama3", Provider: "ollama", APIBase: "http://ollama:11434/v1"}
+		Expect(CheckAPIKey(ollama, []byte(`"sk-123"`))).To(HaveOccurred())
+
+		By("accepting the raw keys of providers without prefix")
+		anthropic := gatewayv1alpha1.AiModel{Name: "claude-3-5-sonnet", Provider: "anthropic"}
+		Expect(CheckAPIKey(anthropic, []byte(`"sk-ant-123"`))).To(Succeed())
+	})
+
+	It("Should reject providers not supported by Kong", func() {
+		_, _, err := PluginConfig("default", gatewayv1alpha1.AiModel{Name: "command-r", Provider: "cohere"})
+		Expect(err).To(MatchError(`provider "cohere" is not supported by Kong`))
+	})
+})
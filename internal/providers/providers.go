@@ -0,0 +1,169 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package providers queries the APIs of model providers, e.g. to list the models they offer.
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// ModelLister lists the models a provider currently offers.
+type ModelLister interface {
+	ListModels(ctx context.Context) ([]string, error)
+}
+
+// providerAPIs are the model list APIs of the supported providers, by provider, and the environment variable
+// holding the API key of the provider.
+var providerAPIs = map[string]struct {
+	envVar, url string
+	header      func(apiKey string) http.Header
+}{
+	"openai":  {"OPENAI_API_KEY", "https://api.openai.com/v1/models", bearerHeader},
+	"mistral": {"MISTRAL_API_KEY", "https://api.mistral.ai/v1/models", bearerHeader},
+	"anthropic": {"ANTHROPIC_API_KEY", "https://api.anthropic.com/v1/models", func(apiKey string) http.Header {
+		return http.Header{"X-Api-Key": {apiKey}, "Anthropic-Version": {"2023-06-01"}}
+	}},
+}
+
+func bearerHeader(apiKey string) http.Header {
+	return http.Header{"Authorization": {"Bearer " + apiKey}}
+}
+
+// NewModelLister returns the model lister of the provider authenticating with apiKey, or false if the provider
+// has no supported model list API. The API base, e.g. "https://llm.example.com/v1", overrides the URL of the
+// provider API if set.
+func NewModelLister(provider, apiBase, apiKey string) (ModelLister, bool) {
+	api, ok := providerAPIs[provider]
+	if !ok {
+		return nil, false
+	}
+	listURL := api.url
+	if apiBase != "" {
+		listURL = strings.TrimSuffix(apiBase, "/") + "/models"
+	}
+	return &HTTPModelLister{URL: listURL, Header: api.header(apiKey)}, true
+}
+
+// ModelListersFromEnv returns the model listers of the providers whose API key is set in the environment of the
+// manager, by provider.
+func ModelListersFromEnv() map[string]ModelLister {
+	listers := map[string]ModelLister{}
+	for provider, api := range providerAPIs {
+		if apiKey := os.Getenv(api.envVar); apiKey != "" {
+			listers[provider], _ = NewModelLister(provider, "", apiKey)
+		}
+	}
+	return listers
+}
+
+// HTTPModelLister lists models via an OpenAI-compatible "GET /v1/models" API, which Anthropic and Mistral offer
+// as well. Paginated responses, as returned by Anthropic, are followed via their "last_id".
+type HTTPModelLister struct {
+	// URL is the URL of the model list API.
+	URL string
+	// Header is sent with every request, e.g. the API key.
+	Header http.Header
+	// Client sends the requests; http.DefaultClient is used if nil.
+	Client *http.Client
+}
+
+// modelList is the response of a model list API.
+type modelList struct {
+	Data []struct {
+		ID string `json:"id"`
+	} `json:"data"`
+	HasMore bool   `json:"has_more"`
+	LastID  string `json:"last_id"`
+}
+
+// StatusError is returned if a provider API responds with an unexpected status.
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("GET %s: %s", e.URL, e.Status)
+}
+
+// IsUnauthorized reports whether the provider API rejected the API key.
+func IsUnauthorized(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) &&
+		(statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden)
+}
+
+// ListModels returns the IDs of all models of the provider.
+func (l *HTTPModelLister) ListModels(ctx context.Context) ([]string, error) {
+	httpClient := l.Client
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	var models []string
+	for afterID := ""; ; {
+		page, err := l.listPage(ctx, httpClient, afterID)
+		if err != nil {
+			return nil, err
+		}
+		for _, model := range page.Data {
+			models = append(models, model.ID)
+		}
+		if !page.HasMore || page.LastID == "" {
+			return models, nil
+		}
+		afterID = page.LastID
+	}
+}
+
+func (l *HTTPModelLister) listPage(ctx context.Context, httpClient *http.Client, afterID string) (*modelList, error) {
+	pageURL := l.URL
+	if afterID != "" {
+		separator := "?"
+		if strings.Contains(pageURL, "?") {
+			separator = "&"
+		}
+		pageURL += separator + "after_id=" + url.QueryEscape(afterID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range l.Header {
+		req.Header[key] = values
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{URL: l.URL, StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	var page modelList
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("GET %s: invalid response: %w", l.URL, err)
+	}
+	return &page, nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Model listers", func() {
+	It("Should follow the pages of a model list API", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/v1/models"))
+			Expect(r.Header.Get("X-Api-Key")).To(Equal("secret"))
+			page := map[string]any{"data": []map[string]string{{"id": "claude-3-opus-20240229"}},
+				"has_more": true, "last_id": "claude-3-opus-20240229"}
+			if r.URL.Query().Get("after_id") != "" {
+				page = map[string]any{"data": []map[string]string{{"id": "claude-3-haiku-20240307"}}}
+			}
+			Expect(json.NewEncoder(w).Encode(page)).To(Succeed())
+		}))
+		defer server.Close()
+
+		lister, ok := NewModelLister("anthropic", server.URL+"/v1/", "secret")
+		Expect(ok).To(BeTrue())
+		Expect(lister.ListModels(context.Background())).To(
+			Equal([]string{"claude-3-opus-20240229", "claude-3-haiku-20240307"}))
+	})
+
+	It("Should report a rejected API key", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer invalid"))
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		lister, _ := NewModelLister("openai", server.URL+"/v1", "invalid")
+		_, err := lister.ListModels(context.Background())
+		Expect(err).To(HaveOccurred())
+		Expect(IsUnauthorized(err)).To(BeTrue())
+	})
+
+	It("Should not support providers without a model list API", func() {
+		_, ok := NewModelLister("bedrock", "", "secret")
+		Expect(ok).To(BeFalse())
+	})
+})
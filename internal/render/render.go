@@ -0,0 +1,245 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render outputs the resources derived from an AiGateway without applying them, for reviews of
+// GitOps changes and debugging: the effective AiGateway implementations reconcile, with its baselines and
+// model libraries resolved, and the discovery ConfigMaps published for it. The workload of the gateway,
+// e.g. its Deployment, is specific to the implementation and rendered by the implementation operator, unless
+// the AiGatewayClass of the gateway references templates for it.
+package render
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// Renderer renders the resources derived from an AiGateway.
+type Renderer struct {
+	// Client reads the AiGateway, its baselines, the AiModelLibraries it imports, the Namespaces
+	// selected for discovery and the templates of its AiGatewayClass.
+	Client client.Client
+	// ClassNamespace is the namespace of the AiGatewayClasses, see gatewayv1alpha1.ResolveClass.
+	ClassNamespace string
+}
+
+// Render returns the effective AiGateway identified by key, without its status, followed by its
+// discovery ConfigMaps and the resources rendered from the templates of its AiGatewayClass.
+func (r *Renderer) Render(ctx context.Context, key types.NamespacedName) ([]client.Object, error) {
+	aiGateway, err := r.Effective(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	configMaps, err := r.discoveryConfigMaps(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	templated, err := r.templatedResources(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	aiGateway.Status = gatewayv1alpha1.AiGatewayStatus{}
+
+	objs := []client.Object{aiGateway}
+	for _, configMap := range configMaps {
+		objs = append(objs, configMap)
+	}
+	objs = append(objs, templated...)
+	for _, obj := range objs {
+		gvk, err := apiutil.GVKForObject(obj, r.Client.Scheme())
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		obj.SetManagedFields(nil)
+	}
+	return objs, nil
+}
+
+// Effective returns the AiGateway identified by key with the models of its AiModelLibraries imported and
+// the settings of its baselines inherited, as implementations reconcile it. Its models are normalized, as the
+// defaulting webhook does not normalize the models of AiModelLibraries.
+func (r *Renderer) Effective(ctx context.Context, key types.NamespacedName) (*gatewayv1alpha1.AiGateway, error) {
+	var aiGateway gatewayv1alpha1.AiGateway
+	if err := r.Client.Get(ctx, key, &aiGateway); err != nil {
+		return nil, fmt.Errorf("failed to get AiGateway %s: %w", key, err)
+	}
+	spec, err := r.effectiveSpec(ctx, key, &aiGateway.Spec, map[types.NamespacedName]bool{key: true})
+	if err != nil {
+		return nil, err
+	}
+	for i := range spec.AiModels {
+		spec.AiModels[i].Normalize()
+	}
+	aiGateway.Spec = *spec
+	return &aiGateway, nil
+}
+
+// effectiveSpec resolves the model libraries and the baselines of the spec of the AiGateway identified by key.
+// visited holds the AiGateways of the chain of baselines resolved so far.
+func (r *Renderer) effectiveSpec(ctx context.Context, key types.NamespacedName, spec *gatewayv1alpha1.AiGatewaySpec,
+	visited map[types.NamespacedName]bool) (*gatewayv1alpha1.AiGatewaySpec, error) {
+	spec = spec.DeepCopy()
+
+	var imported []gatewayv1alpha1.AiModel
+	for _, library := range spec.ModelLibraries {
+		var modelLibrary gatewayv1alpha1.AiModelLibrary
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: library.Name}, &modelLibrary); err != nil {
+			return nil, fmt.Errorf("failed to get AiModelLibrary %s: %w", library.Name, err)
+		}
+		imported = append(imported, modelLibrary.Select(library.Models)...)
+	}
+	spec.AiModels = gatewayv1alpha1.MergeModels(imported, spec.AiModels)
+	spec.ModelLibraries = nil
+
+	ref := spec.Extends
+	if ref == nil {
+		return spec, nil
+	}
+	if len(visited) > gatewayv1alpha1.MaxExtendsDepth {
+		return nil, fmt.Errorf("AiGateway %s: more than %d baselines are not supported",
+			key, gatewayv1alpha1.MaxExtendsDepth)
+	}
+
+	if ref.Kind == gatewayv1alpha1.ExtendsKindAiModelLibrary {
+		var library gatewayv1alpha1.AiModelLibrary
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: ref.Name}, &library); err != nil {
+			return nil, fmt.Errorf("failed to get AiModelLibrary %s: %w", ref.Name, err)
+		}
+		spec.AiModels = gatewayv1alpha1.MergeModels(library.Spec.Models, spec.AiModels)
+		return spec, nil
+	}
+
+	baseKey := types.NamespacedName{Namespace: key.Namespace, Name: ref.Name}
+	if ref.Namespace != "" {
+		baseKey.Namespace = ref.Namespace
+	}
+	if visited[baseKey] {
+		return nil, fmt.Errorf("AiGateway %s: baselines must not form a cycle", baseKey)
+	}
+	visited[baseKey] = true
+
+	var base gatewayv1alpha1.AiGateway
+	if err := r.Client.Get(ctx, baseKey, &base); err != nil {
+		return nil, fmt.Errorf("failed to get AiGateway %s: %w", baseKey, err)
+	}
+	if !base.ExtendableFrom(key.Namespace) {
+		return nil, fmt.Errorf("AiGateway %s cannot be extended from namespace %q, see %s", baseKey, key.Namespace,
+			gatewayv1alpha1.ExtendableFromAnnotation)
+	}
+	baseSpec, err := r.effectiveSpec(ctx, baseKey, &base.Spec, visited)
+	if err != nil {
+		return nil, err
+	}
+	spec.InheritFrom(baseSpec)
+	return spec, nil
+}
+
+// discoveryConfigMaps returns the discovery ConfigMaps of the namespace of the AiGateway and of the namespaces
+// selected by spec.discovery.namespaceSelector. Precedence between gateways publishing into the same namespace
+// is not considered.
+func (r *Renderer) discoveryConfigMaps(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) ([]*corev1.ConfigMap, error) {
+	namespaces := []string{aiGateway.Namespace}
+	if discovery := aiGateway.Spec.Discovery; discovery != nil && discovery.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(discovery.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid discovery namespace selector: %w", err)
+		}
+		var list corev1.NamespaceList
+		if err := r.Client.List(ctx, &list, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+		for _, namespace := range list.Items {
+			if namespace.Name != aiGateway.Namespace {
+				namespaces = append(namespaces, namespace.Name)
+			}
+		}
+	}
+
+	var configMaps []*corev1.ConfigMap
+	for _, namespace := range namespaces {
+		configMaps = append(configMaps, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: gatewayv1alpha1.DiscoveryConfigMapName, Namespace: namespace},
+			Data:       gatewayv1alpha1.DiscoveryData(aiGateway),
+		})
+	}
+	return configMaps, nil
+}
+
+// templatedResources renders the templates referenced by the AiGatewayClass of the AiGateway, in the order
+// implementations apply them. Gateways without class, or whose class does not exist, have none. The class is
+// resolved like implementations do, see gatewayv1alpha1.ResolveClass.
+func (r *Renderer) templatedResources(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway) ([]client.Object,
+	error) {
+	className := aiGateway.Spec.AiGatewayClassName
+	if className == "" {
+		return nil, nil
+	}
+	var aiGatewayClasses gatewayv1alpha1.AiGatewayClassList
+	if err := r.Client.List(ctx, &aiGatewayClasses, client.InNamespace(r.ClassNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AiGatewayClass resources: %w", err)
+	}
+	aiGatewayClass := gatewayv1alpha1.ResolveClass(aiGatewayClasses.Items, className, r.ClassNamespace)
+	if aiGatewayClass == nil || aiGatewayClass.Spec.TemplatesRef == nil {
+		return nil, nil
+	}
+	ref := aiGatewayClass.Spec.TemplatesRef
+
+	key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+	if key.Namespace == "" {
+		key.Namespace = aiGatewayClass.Namespace
+	}
+	var templates corev1.ConfigMap
+	if err := r.Client.Get(ctx, key, &templates); err != nil {
+		return nil, fmt.Errorf("failed to get templates ConfigMap %s: %w", key, err)
+	}
+	rendered, err := gatewayv1alpha1.RenderTemplates(templates.Data, aiGateway)
+	if err != nil {
+		return nil, fmt.Errorf("AiGatewayClass %s: %w", className, err)
+	}
+
+	var objs []client.Object
+	for _, templateKey := range gatewayv1alpha1.TemplateKeys() {
+		if obj, ok := rendered[templateKey]; ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+// WriteYAML writes the objects to w as a multi-document YAML stream.
+func WriteYAML(w io.Writer, objs []client.Object) error {
+	for _, obj := range objs {
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", obj.GetName(), err)
+		}
+		if _, err := fmt.Fprintf(w, "---\n%s", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
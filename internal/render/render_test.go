@@ -0,0 +1,183 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Render", func() {
+	var (
+		ctx      context.Context
+		scheme   *runtime.Scheme
+		platform *gatewayv1alpha1.AiGateway
+		library  *gatewayv1alpha1.AiModelLibrary
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		platform = &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform", Namespace: "platform",
+				Annotations: map[string]string{gatewayv1alpha1.ExtendableFromAnnotation: "team-a"}},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				Port:     4000,
+				AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				Logging:  &gatewayv1alpha1.LoggingConfig{Level: gatewayv1alpha1.LogLevelInfo},
+			},
+		}
+		library = &gatewayv1alpha1.AiModelLibrary{
+			ObjectMeta: metav1.ObjectMeta{Name: "shared"},
+			Spec: gatewayv1alpha1.AiModelLibrarySpec{
+				Models: []gatewayv1alpha1.AiModel{
+					{Name: "Anthropic/claude-3-5-sonnet", Provider: "Anthropic", Alias: "claude"},
+					{Name: "mistral-large", Provider: "mistral"},
+				},
+			},
+		}
+	})
+
+	It("Should render the effective AiGateway and its discovery ConfigMaps", func() {
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team-a"},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				Extends:        &gatewayv1alpha1.ExtendsRef{Name: "platform", Namespace: "platform"},
+				ModelLibraries: []gatewayv1alpha1.ModelLibraryImport{{Name: "shared", Models: []string{"claude"}}},
+				Discovery: &gatewayv1alpha1.DiscoveryConfig{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				},
+			},
+			Status: gatewayv1alpha1.AiGatewayStatus{URL: "http://team.team-a.svc.cluster.local:4000"},
+		}
+		agents := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "agents-a", Labels: map[string]string{"team": "a"}}}
+		other := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "agents-b", Labels: map[string]string{"team": "b"}}}
+		renderer := &Renderer{Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(platform, library, aiGateway, agents, other).Build()}
+
+		objs, err := renderer.Render(ctx, types.NamespacedName{Namespace: "team-a", Name: "team"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs).To(HaveLen(3))
+
+		effective, ok := objs[0].(*gatewayv1alpha1.AiGateway)
+		Expect(ok).To(BeTrue())
+		Expect(effective.Kind).To(Equal("AiGateway"))
+		Expect(effective.Spec.Port).To(Equal(int32(4000)))
+		Expect(effective.Spec.Logging).To(Equal(platform.Spec.Logging))
+		Expect(effective.Spec.ModelLibraries).To(BeEmpty())
+		Expect(effective.PublicModelNames()).To(Equal([]string{"claude", "gpt-4o"}))
+		Expect(effective.Spec.AiModels).To(ContainElement(gatewayv1alpha1.AiModel{
+			Name: "anthropic/claude-3-5-sonnet", Provider: "anthropic", Alias: "claude",
+		}))
+		Expect(effective.Status).To(Equal(gatewayv1alpha1.AiGatewayStatus{}))
+
+		var namespaces []string
+		for _, obj := range objs[1:] {
+			configMap, ok := obj.(*corev1.ConfigMap)
+			Expect(ok).To(BeTrue())
+			Expect(configMap.Name).To(Equal(gatewayv1alpha1.DiscoveryConfigMapName))
+			Expect(configMap.Data).To(HaveKeyWithValue(gatewayv1alpha1.DiscoveryURLKey,
+				"http://team.team-a.svc.cluster.local:4000"))
+			Expect(configMap.Data).To(HaveKeyWithValue(gatewayv1alpha1.DiscoveryModelsKey, "claude\ngpt-4o"))
+			namespaces = append(namespaces, configMap.Namespace)
+		}
+		Expect(namespaces).To(Equal([]string{"team-a", "agents-a"}))
+
+		var out bytes.Buffer
+		Expect(WriteYAML(&out, objs)).To(Succeed())
+		Expect(out.String()).To(HavePrefix("---\napiVersion: agentic-layer.ai/v1alpha1\nkind: AiGateway\n"))
+		Expect(bytes.Count(out.Bytes(), []byte("---\n"))).To(Equal(3))
+	})
+
+	It("Should render the templates of the AiGatewayClass", func() {
+		platform.Spec.AiGatewayClassName = "custom"
+		aiGatewayClass := &gatewayv1alpha1.AiGatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "platform"},
+			Spec: gatewayv1alpha1.AiGatewayClassSpec{
+				Controller:   "litellm.agentic-layer.ai/controller",
+				TemplatesRef: &gatewayv1alpha1.TemplatesReference{Name: "custom-templates", Namespace: "platform"},
+			},
+		}
+		templates := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom-templates", Namespace: "platform"},
+			Data: map[string]string{
+				gatewayv1alpha1.TemplateKeyService: "apiVersion: v1\nkind: Service\nspec:\n" +
+					"  ports:\n  - port: {{ .Spec.Port }}\n",
+			},
+		}
+		renderer := &Renderer{Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(platform, aiGatewayClass, templates).Build(), ClassNamespace: "platform"}
+
+		objs, err := renderer.Render(ctx, types.NamespacedName{Namespace: "platform", Name: "platform"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(objs).To(HaveLen(3))
+
+		service := objs[2]
+		Expect(service.GetObjectKind().GroupVersionKind().Kind).To(Equal("Service"))
+		Expect(service.GetNamespace()).To(Equal("platform"))
+		Expect(service.GetName()).To(Equal("platform"))
+
+		var out bytes.Buffer
+		Expect(WriteYAML(&out, objs)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("  - port: 4000\n"))
+	})
+
+	It("Should fail if the baselines form a cycle", func() {
+		platform.Spec.Extends = &gatewayv1alpha1.ExtendsRef{Name: "team", Namespace: "team-a"}
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team-a"},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				Extends: &gatewayv1alpha1.ExtendsRef{Name: "platform", Namespace: "platform"},
+			},
+		}
+		renderer := &Renderer{Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(platform, aiGateway).Build()}
+
+		_, err := renderer.Render(ctx, types.NamespacedName{Namespace: "team-a", Name: "team"})
+		Expect(err).To(MatchError(ContainSubstring("baselines must not form a cycle")))
+	})
+
+	It("Should fail if the baseline cannot be extended from the namespace of the gateway", func() {
+		platform.Annotations = nil
+		aiGateway := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "team", Namespace: "team-a"},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				Extends: &gatewayv1alpha1.ExtendsRef{Name: "platform", Namespace: "platform"},
+			},
+		}
+		renderer := &Renderer{Client: fake.NewClientBuilder().WithScheme(scheme).
+			WithObjects(platform, aiGateway).Build()}
+
+		_, err := renderer.Render(ctx, types.NamespacedName{Namespace: "team-a", Name: "team"})
+		Expect(err).To(MatchError(`AiGateway platform/platform cannot be extended from namespace "team-a", ` +
+			"see agentic-layer.ai/extendable-from"))
+	})
+})
@@ -0,0 +1,309 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package supportbundle collects everything maintainers need to analyze a bug report against an AiGateway:
+// the custom resource, the resources rendered for it, its conditions, recent Events and redacted router logs.
+package supportbundle
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+const redacted = "[REDACTED]"
+
+// redactionPatterns match credentials that commonly show up in router logs and rendered configuration.
+var redactionPatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+`), "${1}" + redacted},
+	{regexp.MustCompile(`sk-[A-Za-z0-9_-]{8,}`), redacted},
+	{regexp.MustCompile(`(?i)("?[a-z_-]*(?:api[_-]?key|master[_-]?key|password|secret|token)"?\s*[:=]\s*"?)[^\s"',}]+`),
+		"${1}" + redacted},
+}
+
+// Redact masks API keys, bearer tokens and other credentials in data.
+func Redact(data []byte) []byte {
+	for _, r := range redactionPatterns {
+		data = r.pattern.ReplaceAll(data, []byte(r.replacement))
+	}
+	return data
+}
+
+// File is a single file of a support bundle.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Bundle holds the files collected for an AiGateway.
+type Bundle struct {
+	// Name is used as the top-level directory of the archive.
+	Name  string
+	Files []File
+}
+
+// Collector gathers the diagnostic data of a single AiGateway.
+type Collector struct {
+	// Client reads the AiGateway, the resources it owns and its Events.
+	Client client.Client
+	// Clientset fetches pod logs. Logs are skipped if it is nil.
+	Clientset kubernetes.Interface
+	// LogTailLines limits the number of log lines collected per container.
+	LogTailLines int64
+}
+
+// Collect gathers the support bundle for the AiGateway identified by key.
+// Secrets are never collected, and all collected content is redacted.
+func (c *Collector) Collect(ctx context.Context, key types.NamespacedName) (*Bundle, error) {
+	var aiGateway gatewayv1alpha1.AiGateway
+	if err := c.Client.Get(ctx, key, &aiGateway); err != nil {
+		return nil, fmt.Errorf("failed to get AiGateway %s: %w", key, err)
+	}
+
+	bundle := &Bundle{Name: fmt.Sprintf("%s-%s-support-bundle", key.Namespace, key.Name)}
+	if err := c.addObject(bundle, "aigateway.yaml", &aiGateway); err != nil {
+		return nil, err
+	}
+	bundle.add("conditions.txt", formatConditions(aiGateway.Status.Conditions))
+
+	owned, err := c.ownedResources(ctx, &aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	ownerUIDs := map[types.UID]bool{aiGateway.UID: true}
+	for _, obj := range owned {
+		ownerUIDs[obj.GetUID()] = true
+		name := fmt.Sprintf("resources/%s-%s.yaml", strings.ToLower(obj.GetObjectKind().GroupVersionKind().Kind),
+			obj.GetName())
+		if err := c.addObject(bundle, name, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := c.events(ctx, key.Namespace, ownerUIDs)
+	if err != nil {
+		return nil, err
+	}
+	bundle.add("events.txt", formatEvents(events))
+
+	if c.Clientset != nil {
+		for _, obj := range owned {
+			if deployment, ok := obj.(*appsv1.Deployment); ok {
+				if err := c.addLogs(ctx, bundle, deployment); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return bundle, nil
+}
+
+// WriteArchive writes the bundle as a gzipped tarball to w.
+func (b *Bundle) WriteArchive(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, f := range b.Files {
+		header := &tar.Header{
+			Name:    b.Name + "/" + f.Name,
+			Mode:    0o644,
+			Size:    int64(len(f.Content)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return fmt.Errorf("failed to write archive header for %s: %w", f.Name, err)
+		}
+		if _, err := tw.Write(f.Content); err != nil {
+			return fmt.Errorf("failed to write %s to archive: %w", f.Name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to close archive: %w", err)
+	}
+	return gz.Close()
+}
+
+func (b *Bundle) add(name string, content []byte) {
+	b.Files = append(b.Files, File{Name: name, Content: Redact(content)})
+}
+
+// addObject adds obj as YAML to the bundle, including its kind and without managed fields.
+func (c *Collector) addObject(bundle *Bundle, name string, obj client.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Client.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetManagedFields(nil)
+
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s %s: %w", gvk.Kind, obj.GetName(), err)
+	}
+	bundle.add(name, data)
+	return nil
+}
+
+// ownedResources returns the resources in the gateway's namespace that are owned by the gateway.
+func (c *Collector) ownedResources(ctx context.Context, aiGateway *gatewayv1alpha1.AiGateway) ([]client.Object, error) {
+	lists := []client.ObjectList{
+		&appsv1.DeploymentList{},
+		&corev1.ServiceList{},
+		&corev1.ConfigMapList{},
+		&corev1.ServiceAccountList{},
+	}
+
+	var owned []client.Object
+	for _, list := range lists {
+		if err := c.Client.List(ctx, list, client.InNamespace(aiGateway.Namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list %T: %w", list, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract items of %T: %w", list, err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if ok && metav1.IsControlledBy(obj, aiGateway) {
+				gvk, err := apiutil.GVKForObject(obj, c.Client.Scheme())
+				if err != nil {
+					return nil, err
+				}
+				obj.GetObjectKind().SetGroupVersionKind(gvk)
+				owned = append(owned, obj)
+			}
+		}
+	}
+	return owned, nil
+}
+
+// events returns the Events involving one of the given objects, oldest first.
+func (c *Collector) events(ctx context.Context, namespace string, uids map[types.UID]bool) ([]corev1.Event, error) {
+	var list corev1.EventList
+	if err := c.Client.List(ctx, &list, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	var events []corev1.Event
+	for _, event := range list.Items {
+		if uids[event.InvolvedObject.UID] {
+			events = append(events, event)
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i]).Before(eventTime(events[j]))
+	})
+	return events, nil
+}
+
+// addLogs adds the logs of all containers of the pods belonging to deployment.
+func (c *Collector) addLogs(ctx context.Context, bundle *Bundle, deployment *appsv1.Deployment) error {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf("invalid selector of deployment %s: %w", deployment.Name, err)
+	}
+
+	var pods corev1.PodList
+	if err := c.Client.List(ctx, &pods, client.InNamespace(deployment.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list pods of deployment %s: %w", deployment.Name, err)
+	}
+
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			name := fmt.Sprintf("logs/%s/%s.log", pod.Name, container.Name)
+			logs, err := c.containerLogs(ctx, pod, container.Name)
+			if err != nil {
+				// Logs of a single container must not prevent collecting the rest of the bundle.
+				logs = []byte(fmt.Sprintf("failed to fetch logs: %v\n", err))
+			}
+			bundle.add(name, logs)
+		}
+	}
+	return nil
+}
+
+func (c *Collector) containerLogs(ctx context.Context, pod corev1.Pod, container string) ([]byte, error) {
+	options := &corev1.PodLogOptions{Container: container}
+	if c.LogTailLines > 0 {
+		options.TailLines = &c.LogTailLines
+	}
+	stream, err := c.Clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, options).Stream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stream.Close() }()
+	return io.ReadAll(stream)
+}
+
+func formatConditions(conditions []metav1.Condition) []byte {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "TYPE\tSTATUS\tREASON\tLAST TRANSITION\tMESSAGE")
+	for _, condition := range conditions {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason,
+			condition.LastTransitionTime.UTC().Format(time.RFC3339), condition.Message)
+	}
+	_ = tw.Flush()
+	return []byte(sb.String())
+}
+
+func formatEvents(events []corev1.Event) []byte {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "LAST SEEN\tTYPE\tREASON\tOBJECT\tCOUNT\tMESSAGE")
+	for _, event := range events {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s/%s\t%d\t%s\n", eventTime(event).UTC().Format(time.RFC3339),
+			event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Count, event.Message)
+	}
+	_ = tw.Flush()
+	return []byte(sb.String())
+}
+
+// eventTime returns the most meaningful timestamp of an Event.
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
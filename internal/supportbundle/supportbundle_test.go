@@ -0,0 +1,170 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supportbundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	fakeclientset "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Support Bundle", func() {
+	var (
+		ctx       context.Context
+		scheme    *runtime.Scheme
+		aiGateway *gatewayv1alpha1.AiGateway
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(gatewayv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		aiGateway = &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-gateway", Namespace: "default", UID: "gateway-uid"},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				Port:     4000,
+				AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4", Provider: "openai"}},
+			},
+			Status: gatewayv1alpha1.AiGatewayStatus{
+				Conditions: []metav1.Condition{
+					{Type: "Ready", Status: metav1.ConditionFalse, Reason: "ProviderDownError", Message: "upstream 503"},
+				},
+			},
+		}
+	})
+
+	Context("When redacting content", func() {
+		It("Should mask API keys and tokens", func() {
+			redactedContent := string(Redact([]byte(
+				"Authorization: Bearer abc.def\n" +
+					"using key sk-proj-1234567890abcdef\n" +
+					"master_key: supersecret\n" +
+					`{"api_key": "plain-key"}`,
+			)))
+			Expect(redactedContent).NotTo(ContainSubstring("abc.def"))
+			Expect(redactedContent).NotTo(ContainSubstring("sk-proj-1234567890abcdef"))
+			Expect(redactedContent).NotTo(ContainSubstring("supersecret"))
+			Expect(redactedContent).NotTo(ContainSubstring("plain-key"))
+			Expect(redactedContent).To(ContainSubstring("master_key: [REDACTED]"))
+		})
+	})
+
+	Context("When collecting a support bundle", func() {
+		It("Should collect the gateway, its owned resources, events and logs", func() {
+			controller := true
+			ownerRef := metav1.OwnerReference{
+				APIVersion: gatewayv1alpha1.GroupVersion.String(),
+				Kind:       "AiGateway",
+				Name:       aiGateway.Name,
+				UID:        aiGateway.UID,
+				Controller: &controller,
+			}
+			labels := map[string]string{"app": "my-gateway"}
+			deployment := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-gateway", Namespace: "default", UID: "deployment-uid",
+					OwnerReferences: []metav1.OwnerReference{ownerRef},
+				},
+				Spec: appsv1.DeploymentSpec{Selector: &metav1.LabelSelector{MatchLabels: labels}},
+			}
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-gateway-config", Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{ownerRef},
+				},
+				Data: map[string]string{"config.yaml": "general_settings:\n  master_key: sk-1234567890abcdef\n"},
+			}
+			unrelated := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"}}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-gateway-abc", Namespace: "default", Labels: labels},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "litellm"}}},
+			}
+			event := &corev1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "my-gateway.1", Namespace: "default"},
+				InvolvedObject: corev1.ObjectReference{Kind: "AiGateway", Name: "my-gateway", UID: aiGateway.UID},
+				Type:           corev1.EventTypeWarning,
+				Reason:         "ConfigRendered",
+				Message:        "rendered configuration",
+			}
+
+			collector := &Collector{
+				Client: fake.NewClientBuilder().WithScheme(scheme).
+					WithObjects(aiGateway, deployment, configMap, unrelated, pod, event).Build(),
+				Clientset:    fakeclientset.NewClientset(pod),
+				LogTailLines: 100,
+			}
+
+			bundle, err := collector.Collect(ctx, types.NamespacedName{Namespace: "default", Name: "my-gateway"})
+			Expect(err).NotTo(HaveOccurred())
+
+			files := map[string]string{}
+			for _, f := range bundle.Files {
+				files[f.Name] = string(f.Content)
+			}
+			Expect(files).To(HaveKey("aigateway.yaml"))
+			Expect(files["aigateway.yaml"]).To(ContainSubstring("kind: AiGateway"))
+			Expect(files["conditions.txt"]).To(ContainSubstring("ProviderDownError"))
+			Expect(files).To(HaveKey("resources/deployment-my-gateway.yaml"))
+			Expect(files).To(HaveKey("resources/configmap-my-gateway-config.yaml"))
+			Expect(files).NotTo(HaveKey("resources/configmap-unrelated.yaml"))
+			Expect(files["resources/configmap-my-gateway-config.yaml"]).NotTo(ContainSubstring("sk-1234567890abcdef"))
+			Expect(files["events.txt"]).To(ContainSubstring("ConfigRendered"))
+			Expect(files).To(HaveKey("logs/my-gateway-abc/litellm.log"))
+
+			By("writing the bundle as an archive")
+			var buf bytes.Buffer
+			Expect(bundle.WriteArchive(&buf)).To(Succeed())
+			gz, err := gzip.NewReader(&buf)
+			Expect(err).NotTo(HaveOccurred())
+			tr := tar.NewReader(gz)
+			var names []string
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				Expect(err).NotTo(HaveOccurred())
+				names = append(names, header.Name)
+			}
+			Expect(names).To(ContainElement("default-my-gateway-support-bundle/aigateway.yaml"))
+		})
+
+		It("Should fail if the gateway does not exist", func() {
+			collector := &Collector{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+			_, err := collector.Collect(ctx, types.NamespacedName{Namespace: "default", Name: "missing"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("failed to get AiGateway"))
+		})
+	})
+})
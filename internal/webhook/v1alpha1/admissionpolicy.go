@@ -0,0 +1,237 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// AdmissionPolicyName is the name of the ValidatingAdmissionPolicy and its binding validating AiGateways and
+// ClusterAiGateways if the manager runs with --admission-policies.
+const AdmissionPolicyName = "aigateways.agentic-layer.ai"
+
+// AdmissionPolicyChecks lists the checks of the validating webhook the admission policy runs, see
+// admissionPolicyValidations. It is part of the help of --admission-policies.
+const AdmissionPolicyChecks = "that models are specified, the names, aliases, wildcards and provider blocks of " +
+	"spec.aiModels, the gateway and metrics ports, duplicate spec.modelLibraries, the namespace of AiModelLibrary " +
+	"baselines and the annotations of existing ServiceAccounts"
+
+// admissionPolicyVariables are the CEL variables of the admission policy, so the validations need not check for
+// omitted lists.
+var admissionPolicyVariables = []admissionregistrationv1.Variable{
+	{Name: "models", Expression: "has(object.spec.aiModels) ? object.spec.aiModels : []"},
+	{Name: "libraries", Expression: "has(object.spec.modelLibraries) ? " +
+		"object.spec.modelLibraries.map(l, l.name) : []"},
+}
+
+// admissionPolicyValidations mirror a subset of the checks of validateAiGatewaySpec, listed in
+// AdmissionPolicyChecks. All other checks are only run by the validating webhook: those reading other objects,
+// e.g. AiModelPolicies, AiGatewayQuotas, baselines or the allowed providers of the class, and spec checks not
+// ported to CEL, e.g. of duplicate model deployments, spec.settingsFrom, spec.egress, spec.schedules,
+// spec.autoscaling, spec.routing and spec.modelGroups. The policy issues no warnings.
+func admissionPolicyValidations() []admissionregistrationv1.Validation {
+	var ports []int
+	for port := range gatewayv1alpha1.ReservedPorts {
+		ports = append(ports, int(port))
+	}
+	slices.Sort(ports)
+	var portList []string
+	for _, port := range ports {
+		portList = append(portList, strconv.Itoa(port))
+	}
+	reservedPorts := "[" + strings.Join(portList, ", ") + "]"
+	metricsPort := "object.spec.observability.metrics.port"
+	hasMetricsPort := "has(object.spec.observability) && has(object.spec.observability.metrics) && " +
+		"has(object.spec.observability.metrics.port)"
+	providerModelName := func(m string) string {
+		return fmt.Sprintf("(%[1]s.name.startsWith(%[1]s.provider + '/') ? "+
+			"%[1]s.name.substring(size(%[1]s.provider) + 1) : %[1]s.name)", m)
+	}
+	providerBlock := func(block, provider string) admissionregistrationv1.Validation {
+		return admissionregistrationv1.Validation{
+			Expression: fmt.Sprintf("variables.models.all(m, !has(m.%s) || m.provider == '%s')", block, provider),
+			Message:    fmt.Sprintf("spec.aiModels[].%s: Forbidden: only allowed for provider %q", block, provider),
+		}
+	}
+
+	validations := []admissionregistrationv1.Validation{
+		{
+			Expression: "has(object.spec.importConfigRef) || size(variables.models) > 0 || " +
+				"size(variables.libraries) > 0 || has(object.spec.extends)",
+			Message: "spec.aiModels: Required value: no AI models specified in AiGateway",
+		},
+		{
+			Expression: fmt.Sprintf("!has(object.spec.port) || !(object.spec.port in %s)", reservedPorts),
+			Message:    fmt.Sprintf("spec.port: Invalid value: ports %s are reserved for the Istio sidecar", reservedPorts),
+		},
+		{
+			Expression: fmt.Sprintf("!(%s) || !(%s in %s)", hasMetricsPort, metricsPort, reservedPorts),
+			Message: fmt.Sprintf("spec.observability.metrics.port: Invalid value: ports %s are reserved for the "+
+				"Istio sidecar", reservedPorts),
+		},
+		{
+			Expression: fmt.Sprintf("!(%s) || !has(object.spec.port) || %s != object.spec.port",
+				hasMetricsPort, metricsPort),
+			Message: "spec.observability.metrics.port: Invalid value: metrics port must differ from the gateway port",
+		},
+		{
+			Expression: `variables.models.all(m, !m.name.matches('\\s'))`,
+			Message:    "spec.aiModels[].name: Invalid value: AI model name must not contain whitespace",
+		},
+		{
+			Expression: `variables.models.all(m, !m.name.matches('[*].'))`,
+			Message: "spec.aiModels[].name: Invalid value: wildcard '*' is only allowed as the last character " +
+				"of an AI model name",
+		},
+		{
+			Expression: "variables.models.all(m, !m.name.endsWith('*') || !has(m.alias))",
+			Message:    "spec.aiModels[].alias: Forbidden: wildcard models cannot have an alias",
+		},
+		{
+			Expression: fmt.Sprintf("variables.models.all(a, !has(a.alias) || variables.models.all(b, "+
+				"!has(b.alias) || b.alias != a.alias || %s == %s))", providerModelName("b"), providerModelName("a")),
+			Message: "spec.aiModels[].alias: Duplicate value: aliases must be unique, except among deployments of " +
+				"the same model",
+		},
+		{
+			Expression: "variables.models.all(a, !has(a.alias) || variables.models.all(b, has(b.alias) || " +
+				"b.name != a.alias))",
+			Message: "spec.aiModels[].alias: Invalid value: alias collides with the name of another model",
+		},
+		{
+			Expression: "variables.libraries.all(l, variables.libraries.filter(k, k == l).size() == 1)",
+			Message:    "spec.modelLibraries[].name: Duplicate value: a model library must only be imported once",
+		},
+		{
+			Expression: "!has(object.spec.extends) || !has(object.spec.extends.kind) || " +
+				"object.spec.extends.kind != 'AiModelLibrary' || !has(object.spec.extends.namespace)",
+			Message: "spec.extends.namespace: Forbidden: AiModelLibraries are cluster-scoped",
+		},
+		providerBlock("azure", azureProvider),
+		providerBlock("bedrock", bedrockProvider),
+		providerBlock("vertexAI", vertexAIProvider),
+		{
+			Expression: fmt.Sprintf("variables.models.all(m, m.provider != '%s' || has(m.vertexAI))", vertexAIProvider),
+			Message: fmt.Sprintf("spec.aiModels[].vertexAI: Required value: vertexAI configuration is required "+
+				"for provider %q", vertexAIProvider),
+		},
+		{
+			Expression: fmt.Sprintf("variables.models.all(m, !has(m.bedrock) || m.bedrock.region.matches('%s'))",
+				awsRegionPattern),
+			Message: "spec.aiModels[].bedrock.region: Invalid value: must be a valid AWS region (e.g. eu-central-1)",
+		},
+		{
+			Expression: "!has(object.spec.serviceAccount) || !has(object.spec.serviceAccount.name) || " +
+				"!has(object.spec.serviceAccount.annotations)",
+			Message: "spec.serviceAccount.annotations: Forbidden: annotations can only be set on a generated " +
+				"ServiceAccount, not together with name",
+		},
+	}
+	for i := range validations {
+		validations[i].Reason = ptr.To(metav1.StatusReasonInvalid)
+	}
+	return validations
+}
+
+// AdmissionPolicies returns the ValidatingAdmissionPolicy running the spec checks of the AiGateway webhook in the
+// API server, and its binding. Unlike the webhook, the policy keeps validating AiGateways and ClusterAiGateways
+// while the manager is unavailable.
+func AdmissionPolicies() []client.Object {
+	policy := &admissionregistrationv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: AdmissionPolicyName},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicySpec{
+			FailurePolicy: ptr.To(admissionregistrationv1.Fail),
+			MatchConstraints: &admissionregistrationv1.MatchResources{
+				ResourceRules: []admissionregistrationv1.NamedRuleWithOperations{{
+					RuleWithOperations: admissionregistrationv1.RuleWithOperations{
+						Operations: []admissionregistrationv1.OperationType{
+							admissionregistrationv1.Create, admissionregistrationv1.Update,
+						},
+						Rule: admissionregistrationv1.Rule{
+							APIGroups:   []string{gatewayv1alpha1.GroupVersion.Group},
+							APIVersions: []string{gatewayv1alpha1.GroupVersion.Version},
+							Resources:   []string{"aigateways", "clusteraigateways"},
+						},
+					},
+				}},
+			},
+			Variables:   admissionPolicyVariables,
+			Validations: admissionPolicyValidations(),
+		},
+	}
+	binding := &admissionregistrationv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: AdmissionPolicyName},
+		Spec: admissionregistrationv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        AdmissionPolicyName,
+			ValidationActions: []admissionregistrationv1.ValidationAction{admissionregistrationv1.Deny},
+		},
+	}
+	return []client.Object{policy, binding}
+}
+
+// SetupAdmissionPoliciesWithManager replaces the validating webhooks of AiGateway and ClusterAiGateway by the
+// AdmissionPolicies, which the manager applies on start. Only the webhooks depending on other objects
+// are registered: the AiGateway defaulter assigning the default class and the AiGatewayClass validator checking
+// the default class annotation, see SetupAiGatewayClassWebhookWithManager.
+func SetupAdmissionPoliciesWithManager(mgr ctrl.Manager, allowMultipleDefaults bool, classNamespace string) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiGateway{}).
+		WithDefaulter(&tracingDefaulter{kind: "AiGateway", defaulter: &AiGatewayCustomDefaulter{
+			Client: tracingClient{mgr.GetClient()}, ClassNamespace: classNamespace}}).
+		Complete(); err != nil {
+		return err
+	}
+	if err := SetupAiGatewayClassWebhookWithManager(mgr, allowMultipleDefaults, classNamespace); err != nil {
+		return err
+	}
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return ApplyAdmissionPolicies(ctx, mgr.GetClient())
+	}))
+}
+
+// admissionPolicyFieldManager is the field manager of the AdmissionPolicies, the same as controller.FieldManager.
+const admissionPolicyFieldManager = "ai-gateway-operator"
+
+// ApplyAdmissionPolicies server-side applies the AdmissionPolicies. Applying is forced, as the policies must match
+// the webhooks they replace; fields the operator doesn't set, e.g. labels added by other tooling, are kept.
+func ApplyAdmissionPolicies(ctx context.Context, c client.Client) error {
+	for _, obj := range AdmissionPolicies() {
+		gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+		if err != nil {
+			return err
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		if err := c.Patch(ctx, obj, client.Apply, client.FieldOwner(admissionPolicyFieldManager),
+			client.ForceOwnership); err != nil {
+			return fmt.Errorf("failed to apply %s %s: %w", gvk.Kind, obj.GetName(), err)
+		}
+	}
+	return nil
+}
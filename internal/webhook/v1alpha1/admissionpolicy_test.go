@@ -0,0 +1,188 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// failedAdmissionPolicyValidations evaluates the admission policy against the AiGateway and returns the messages
+// of the failed validations.
+func failedAdmissionPolicyValidations(aiGateway *gatewayv1alpha1.AiGateway) []string {
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType), cel.Variable("variables", cel.DynType),
+		ext.Strings())
+	Expect(err).NotTo(HaveOccurred())
+	evaluate := func(expression string, activation map[string]any) any {
+		ast, issues := env.Compile(expression)
+		Expect(issues.Err()).NotTo(HaveOccurred(), expression)
+		program, err := env.Program(ast)
+		Expect(err).NotTo(HaveOccurred(), expression)
+		result, _, err := program.Eval(activation)
+		Expect(err).NotTo(HaveOccurred(), expression)
+		return result.Value()
+	}
+
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(aiGateway)
+	Expect(err).NotTo(HaveOccurred())
+	variables := map[string]any{}
+	activation := map[string]any{"object": object, "variables": variables}
+	policy := AdmissionPolicies()[0].(*admissionregistrationv1.ValidatingAdmissionPolicy)
+	for _, variable := range policy.Spec.Variables {
+		variables[variable.Name] = evaluate(variable.Expression, activation)
+	}
+
+	var failed []string
+	for _, validation := range policy.Spec.Validations {
+		if evaluate(validation.Expression, activation) != true {
+			failed = append(failed, validation.Message)
+		}
+	}
+	return failed
+}
+
+var _ = Describe("Admission Policies", func() {
+	var obj *gatewayv1alpha1.AiGateway
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				Port:     4000,
+				AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai", Alias: "gpt"}},
+			},
+		}
+	})
+
+	It("Should admit a valid AiGateway", func() {
+		Expect(failedAdmissionPolicyValidations(obj)).To(BeEmpty())
+		_, errs := validateAiGatewaySpec(obj)
+		Expect(errs).To(BeEmpty())
+	})
+
+	DescribeTable("Should deny invalid AiGateways like the webhook",
+		func(mutate func(*gatewayv1alpha1.AiGateway), message string) {
+			mutate(obj)
+			Expect(failedAdmissionPolicyValidations(obj)).To(ConsistOf(ContainSubstring(message)))
+			_, errs := validateAiGatewaySpec(obj)
+			Expect(errs).NotTo(BeEmpty())
+		},
+		Entry("without models", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels = nil
+		}, "no AI models specified"),
+		Entry("with a reserved port", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.Port = 15001
+		}, "spec.port: Invalid value: ports [15000"),
+		Entry("with the metrics port of the gateway", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.Observability = &gatewayv1alpha1.ObservabilityConfig{
+				Metrics: &gatewayv1alpha1.MetricsConfig{Port: 4000},
+			}
+		}, "metrics port must differ from the gateway port"),
+		Entry("with a model name containing whitespace", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels[0].Name = "gpt 4o"
+		}, "must not contain whitespace"),
+		Entry("with a wildcard in the middle of a model name", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels[0].Name = "gpt-*-mini"
+		}, "only allowed as the last character"),
+		Entry("with an alias of a wildcard model", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels[0].Name = "gpt-*"
+		}, "wildcard models cannot have an alias"),
+		Entry("with a duplicate alias", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels = append(g.Spec.AiModels,
+				gatewayv1alpha1.AiModel{Name: "gpt-4o-mini", Provider: "openai", Alias: "gpt"})
+		}, "aliases must be unique"),
+		Entry("with an alias colliding with a model name", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels = append(g.Spec.AiModels, gatewayv1alpha1.AiModel{Name: "gpt", Provider: "openai"})
+		}, "alias collides with the name of another model"),
+		Entry("with a model library imported twice", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.ModelLibraries = []gatewayv1alpha1.ModelLibraryImport{{Name: "shared"}, {Name: "shared"}}
+		}, "only be imported once"),
+		Entry("with the namespace of an AiModelLibrary baseline", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.Extends = &gatewayv1alpha1.ExtendsRef{
+				Kind: gatewayv1alpha1.ExtendsKindAiModelLibrary, Name: "shared", Namespace: "default",
+			}
+		}, "AiModelLibraries are cluster-scoped"),
+		Entry("with a Bedrock block of another provider", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels[0].Bedrock = &gatewayv1alpha1.BedrockConfig{Region: "eu-central-1"}
+		}, `bedrock: Forbidden: only allowed for provider "bedrock"`),
+		Entry("with an invalid Bedrock region", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels[0].Provider = "bedrock"
+			g.Spec.AiModels[0].Bedrock = &gatewayv1alpha1.BedrockConfig{Region: "Frankfurt"}
+		}, "must be a valid AWS region"),
+		Entry("with a Vertex AI model without vertexAI", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels[0].Provider = "vertex_ai"
+		}, "vertexAI configuration is required"),
+		Entry("with annotations of an existing ServiceAccount", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.ServiceAccount = &gatewayv1alpha1.GatewayServiceAccount{
+				Name:        "gateway",
+				Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/gateway"},
+			}
+		}, "not together with name"),
+	)
+
+	It("Should admit a shared alias of deployments of the same model like the webhook", func() {
+		obj.Spec.AiModels = append(obj.Spec.AiModels, gatewayv1alpha1.AiModel{
+			Name: "openai/gpt-4o", Provider: "openai", Alias: "gpt", APIBase: "https://eu.api.openai.com/v1",
+		})
+		Expect(failedAdmissionPolicyValidations(obj)).To(BeEmpty())
+		_, errs := validateAiGatewaySpec(obj)
+		Expect(errs).To(BeEmpty())
+	})
+
+	DescribeTable("Should leave the checks not listed in AdmissionPolicyChecks to the webhook",
+		func(mutate func(*gatewayv1alpha1.AiGateway), message string) {
+			mutate(obj)
+			Expect(failedAdmissionPolicyValidations(obj)).To(BeEmpty())
+			_, errs := validateAiGatewaySpec(obj)
+			Expect(errs.ToAggregate()).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("with a duplicate model deployment", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.AiModels = append(g.Spec.AiModels, g.Spec.AiModels[0])
+		}, "same deployment as spec.aiModels[0]"),
+		Entry("with settings without a ConfigMap name", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.SettingsFrom = &gatewayv1alpha1.SettingsFrom{}
+		}, "spec.settingsFrom.configMapRef.name: Required value"),
+		Entry("with an invalid egress proxy", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.Egress = &gatewayv1alpha1.EgressConfig{HTTPSProxy: "proxy.example.com:3128"}
+		}, "spec.egress.httpsProxy"),
+		Entry("with an invalid schedule", func(g *gatewayv1alpha1.AiGateway) {
+			g.Spec.Schedules = []gatewayv1alpha1.ScalingSchedule{{Name: "nights", Start: "every night"}}
+		}, "spec.schedules[0].start"),
+	)
+
+	It("Should create and update the policy and its binding", func() {
+		Expect(ApplyAdmissionPolicies(ctx, k8sClient)).To(Succeed())
+		DeferCleanup(func() {
+			for _, obj := range AdmissionPolicies() {
+				Expect(k8sClient.Delete(ctx, obj)).To(Succeed())
+			}
+		})
+		Expect(ApplyAdmissionPolicies(ctx, k8sClient)).To(Succeed())
+
+		var binding admissionregistrationv1.ValidatingAdmissionPolicyBinding
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Name: AdmissionPolicyName}, &binding)).To(Succeed())
+		Expect(binding.Spec.PolicyName).To(Equal(AdmissionPolicyName))
+	})
+})
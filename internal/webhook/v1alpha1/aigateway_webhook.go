@@ -18,31 +18,69 @@ package v1alpha1
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/providers"
+)
+
+const (
+	azureProvider    = "azure"
+	bedrockProvider  = "bedrock"
+	vertexAIProvider = "vertex_ai"
+
+	irsaRoleAnnotation            = "eks.amazonaws.com/role-arn"
+	workloadIdentityGSAAnnotation = "iam.gke.io/gcp-service-account"
+)
+
+var (
+	awsRegionPattern = regexp.MustCompile(`^[a-z]{2}(-gov)?-[a-z]+-[0-9]+$`)
+	decimalPattern   = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
 )
 
 // nolint:unused
 // log is for logging in this package.
 var aigatewaylog = logf.Log.WithName("aigateway-resource")
 
-// SetupAiGatewayWebhookWithManager registers the webhook for AiGateway in the manager.
-func SetupAiGatewayWebhookWithManager(mgr ctrl.Manager) error {
+// SetupAiGatewayWebhookWithManager registers the webhook for AiGateway in the manager. If verifyCredentials is
+// set, the API keys referenced by the models are checked against their provider on admission. AiGatewayClasses are
+// resolved in classNamespace, see gatewayv1alpha1.ResolveClass.
+func SetupAiGatewayWebhookWithManager(mgr ctrl.Manager, verifyCredentials bool, classNamespace string) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiGateway{}).
-		WithValidator(&AiGatewayCustomValidator{}).
-		WithDefaulter(&AiGatewayCustomDefaulter{}).
+		WithValidator(&tracingValidator{kind: "AiGateway", validator: &AiGatewayCustomValidator{
+			Client: tracingClient{mgr.GetClient()}, VerifyCredentials: verifyCredentials,
+			APIReader:      tracingReader{Reader: mgr.GetAPIReader(), scheme: mgr.GetScheme()},
+			ClassNamespace: classNamespace}}).
+		WithDefaulter(&tracingDefaulter{kind: "AiGateway", defaulter: &AiGatewayCustomDefaulter{
+			Client: tracingClient{mgr.GetClient()}, ClassNamespace: classNamespace}}).
 		Complete()
 }
 
-// +kubebuilder:webhook:path=/mutate-gateway-agentic-layer-ai-v1alpha1-aigateway,mutating=true,failurePolicy=fail,sideEffects=None,groups=gateway.agentic-layer.ai,resources=aigateways,verbs=create;update,versions=v1alpha1,name=aigateway-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-agentic-layer-ai-v1alpha1-aigateway,mutating=true,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aigateways,verbs=create;update,versions=v1alpha1,name=aigateway-v1alpha1.kb.io,admissionReviewVersions=v1
 
 // AiGatewayCustomDefaulter struct is responsible for setting default values on the custom resource of the
 // Kind AiGateway when those are created or updated.
@@ -50,30 +88,66 @@ func SetupAiGatewayWebhookWithManager(mgr ctrl.Manager) error {
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as it is used only for temporary operations and does not need to be deeply copied.
 type AiGatewayCustomDefaulter struct {
+	Client client.Client
+
+	// ClassNamespace is the namespace of the AiGatewayClasses, see gatewayv1alpha1.ResolveClass.
+	ClassNamespace string
 }
 
 var _ webhook.CustomDefaulter = &AiGatewayCustomDefaulter{}
 
 // Default implements webhook.CustomDefaulter so a webhook will be registered for the Kind AiGateway.
-func (d *AiGatewayCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+func (d *AiGatewayCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
 	aiGateway, ok := obj.(*gatewayv1alpha1.AiGateway)
 
 	if !ok {
 		return fmt.Errorf("expected an AiGateway object but got %T", obj)
 	}
-	aigatewaylog.Info("Defaulting for AiGateway", "name", aiGateway.GetName())
+	requestLog(ctx, aigatewaylog, aiGateway).Info("Defaulting for AiGateway")
 
 	const DefaultPort = 4000
 	if aiGateway.Spec.Port == 0 {
 		aiGateway.Spec.Port = DefaultPort
 	}
 
+	if observability := aiGateway.Spec.Observability; observability != nil && observability.Metrics != nil &&
+		observability.Metrics.Port == 0 {
+		observability.Metrics.Port = gatewayv1alpha1.DefaultMetricsPort
+	}
+
+	for i := range aiGateway.Spec.AiModels {
+		aiGateway.Spec.AiModels[i].Normalize()
+	}
+
+	if aiGateway.Spec.AiGatewayClassName == "" {
+		defaultClass, err := d.defaultClassName(ctx)
+		if err != nil {
+			return err
+		}
+		aiGateway.Spec.AiGatewayClassName = defaultClass
+	}
+
 	return nil
 }
 
+// defaultClassName returns the name of the AiGatewayClass annotated as default, or an empty string if there is none.
+// If several classes are marked as default, the oldest wins, see ResolveDefaultClass.
+func (d *AiGatewayCustomDefaulter) defaultClassName(ctx context.Context) (string, error) {
+	var aiGatewayClassList gatewayv1alpha1.AiGatewayClassList
+	if err := d.Client.List(ctx, &aiGatewayClassList, client.InNamespace(d.ClassNamespace)); err != nil {
+		return "", fmt.Errorf("failed to list AiGatewayClass resources: %w", err)
+	}
+
+	defaultClass := gatewayv1alpha1.ResolveDefaultClass(aiGatewayClassList.Items, d.ClassNamespace)
+	if defaultClass == nil {
+		return "", nil
+	}
+	return defaultClass.Name, nil
+}
+
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
-// +kubebuilder:webhook:path=/validate-gateway-agentic-layer-ai-v1alpha1-aigateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=gateway.agentic-layer.ai,resources=aigateways,verbs=create;update,versions=v1alpha1,name=vaigateway-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aigateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aigateways,verbs=create;update,versions=v1alpha1,name=vaigateway-v1alpha1.kb.io,admissionReviewVersions=v1
 
 // AiGatewayCustomValidator struct is responsible for validating the AiGateway resource
 // when it is created, updated, or deleted.
@@ -81,68 +155,2002 @@ func (d *AiGatewayCustomDefaulter) Default(_ context.Context, obj runtime.Object
 // NOTE: The +kubebuilder:object:generate=false marker prevents controller-gen from generating DeepCopy methods,
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type AiGatewayCustomValidator struct {
+	Client client.Client
+
+	// VerifyCredentials enables the check of the API keys referenced by the models against their provider.
+	VerifyCredentials bool
+
+	// APIReader reads the API key Secrets checked with VerifyCredentials uncached, so the manager doesn't cache
+	// the Secrets of the cluster.
+	APIReader client.Reader
+
+	// ClassNamespace is the namespace of the AiGatewayClasses, see gatewayv1alpha1.ResolveClass.
+	ClassNamespace string
+
+	// modelLister returns the model lister of the public API of a provider; providers.NewModelLister if nil.
+	modelLister func(provider, apiKey string) (providers.ModelLister, bool)
 }
 
 var _ webhook.CustomValidator = &AiGatewayCustomValidator{}
 
 // ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type AiGateway.
-func (v *AiGatewayCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *AiGatewayCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	aiGateway, ok := obj.(*gatewayv1alpha1.AiGateway)
 	if !ok {
 		// This error is for the webhook runtime, not the user.
 		return nil, fmt.Errorf("expected a AiGateway object but got %T", obj)
 	}
-	aigatewaylog.Info("Validation for AiGateway upon creation", "name", aiGateway.GetName())
-	return v.validateAiGatewaySpec(aiGateway)
+	requestLog(ctx, aigatewaylog, aiGateway).Info("Validation for AiGateway upon creation")
+	warnings, err := v.validateAiGateway(ctx, aiGateway)
+	recordValidation(ctx, "AiGateway", aiGateway, err)
+	return warnings, err
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiGateway.
-func (v *AiGatewayCustomValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+func (v *AiGatewayCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
 	aiGateway, ok := newObj.(*gatewayv1alpha1.AiGateway)
 	if !ok {
 		return nil, fmt.Errorf("expected a AiGateway object for the newObj but got %T", newObj)
 	}
-	aigatewaylog.Info("Validation for AiGateway upon update", "name", aiGateway.GetName())
-	return v.validateAiGatewaySpec(aiGateway)
+	requestLog(ctx, aigatewaylog, aiGateway).Info("Validation for AiGateway upon update")
+	warnings, err := v.validateAiGateway(ctx, aiGateway)
+	recordValidation(ctx, "AiGateway", aiGateway, err)
+	return warnings, err
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiGateway.
-func (v *AiGatewayCustomValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+func (v *AiGatewayCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
 	aiGateway, ok := obj.(*gatewayv1alpha1.AiGateway)
 	if !ok {
 		return nil, fmt.Errorf("expected a AiGateway object but got %T", obj)
 	}
-	aigatewaylog.Info("Validation for AiGateway upon deletion", "name", aiGateway.GetName())
+	requestLog(ctx, aigatewaylog, aiGateway).Info("Validation for AiGateway upon deletion")
 
 	return nil, nil
 }
 
-// validateAiGatewaySpec contains the core validation logic for the AiGateway spec.
-// It's called by both ValidateCreate and ValidateUpdate.
-func (v *AiGatewayCustomValidator) validateAiGatewaySpec(aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, error) {
+// validateAiGateway validates the AiGateway spec and checks it against the AiModelLibraries it imports, the
+// providers and number of models allowed by its AiGatewayClass and the AiModelPolicies and AiGatewayQuotas of its namespace. It's called by both ValidateCreate and ValidateUpdate.
+func (v *AiGatewayCustomValidator) validateAiGateway(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, error) {
+	warnings, allErrs := validateAiGatewaySpec(aiGateway)
+
+	for _, validate := range []func(context.Context, *gatewayv1alpha1.AiGateway) (field.ErrorList, error){
+		v.validateModelLibraries,
+		v.validateExtends,
+		v.validateModelPolicies,
+		v.validateAllowedProviders,
+		v.validateMaxModels,
+		v.validateQuotas,
+	} {
+		errs, err := validate(ctx, aiGateway)
+		if err != nil {
+			return warnings, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	settingsWarnings, errs, err := v.validateSettings(ctx, aiGateway)
+	if err != nil {
+		return warnings, err
+	}
+	warnings = append(warnings, settingsWarnings...)
+	allErrs = append(allErrs, errs...)
+
+	secretsStoreWarnings, err := v.secretsStoreWarnings(ctx, aiGateway)
+	if err != nil {
+		return warnings, err
+	}
+	warnings = append(warnings, secretsStoreWarnings...)
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+
+	credentialWarnings, err := v.credentialWarnings(ctx, aiGateway)
+	if err != nil {
+		return warnings, err
+	}
+	warnings = append(warnings, credentialWarnings...)
+	if !v.VerifyCredentials {
+		return warnings, nil
+	}
+	verificationWarnings, err := v.verifyCredentials(ctx, aiGateway)
+	return append(warnings, verificationWarnings...), err
+}
+
+// validateSettings validates that the ConfigMap referenced by spec.settingsFrom holds valid LiteLLM settings, so
+// that invalid YAML is rejected at admission rather than breaking the generated configuration. A missing ConfigMap
+// is only warned about, as it may be created after the AiGateway.
+func (v *AiGatewayCustomValidator) validateSettings(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, field.ErrorList, error) {
+	settings := aiGateway.Spec.SettingsFrom
+	if settings == nil || settings.ConfigMapRef.Name == "" {
+		return nil, nil, nil
+	}
+
+	key := client.ObjectKey{Namespace: aiGateway.Namespace, Name: settings.ConfigMapRef.Name}
+	var configMap corev1.ConfigMap
+	if err := v.Client.Get(ctx, key, &configMap); apierrors.IsNotFound(err) {
+		return admission.Warnings{fmt.Sprintf("settings ConfigMap %q does not exist in namespace %q; "+
+			"the gateway is configured without its settings until it is created", key.Name, key.Namespace)}, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to get settings ConfigMap %s: %w", key, err)
+	}
+
+	fldPath := field.NewPath("spec", "settingsFrom", "configMapRef", "name")
+	parsed, err := gatewayv1alpha1.ParseSettings(configMap.Data)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(fldPath, key.Name, err.Error())}, nil
+	}
+	if paths := gatewayv1alpha1.LiteralCredentials(parsed); len(paths) > 0 {
+		return nil, field.ErrorList{field.Invalid(fldPath, key.Name, fmt.Sprintf("settings %s hold literal "+
+			"credentials; reference an environment variable with %q instead, e.g. via the pod template of the "+
+			"AiGatewayClass", strings.Join(paths, ", "), gatewayv1alpha1.EnvironReferencePrefix+"NAME"))}, nil
+	}
+	return nil, nil, nil
+}
+
+// credentialWarnings warns about models referencing Secrets, e.g. provider credentials, that do not exist in the
+// namespace of the AiGateway. These models fail at runtime, but are admitted to allow creating the Secrets after
+// the AiGateway, e.g. in the same apply. Only the metadata of the Secrets is read.
+func (v *AiGatewayCustomValidator) credentialWarnings(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, error) {
+	models, err := v.gatewayModels(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings admission.Warnings
+	exists := make(map[string]bool)
+	for _, model := range models {
+		for _, name := range gatewayv1alpha1.ReferencedModelSecrets([]gatewayv1alpha1.AiModel{model.AiModel}) {
+			found, checked := exists[name]
+			if !checked {
+				secret := &metav1.PartialObjectMetadata{}
+				secret.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Secret"))
+				switch err := v.Client.Get(ctx, client.ObjectKey{Namespace: aiGateway.Namespace, Name: name}, secret); {
+				case apierrors.IsNotFound(err):
+				case err != nil:
+					return nil, fmt.Errorf("failed to get Secret %s: %w", name, err)
+				default:
+					found = true
+				}
+				exists[name] = found
+			}
+			if !found {
+				warnings = append(warnings, fmt.Sprintf("AI model %q references Secret %q, which does not exist in "+
+					"namespace %q; requests to the model will fail until it is created", model.Name, name,
+					aiGateway.Namespace))
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// validateModelPolicies ensures every model of the AiGateway, including the models imported from model libraries,
+// is allowed by all AiModelPolicies in its namespace. Models imported via importConfigRef are only known after
+// reconciliation and are checked by the implementation.
+func (v *AiGatewayCustomValidator) validateModelPolicies(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (field.ErrorList, error) {
+	var policies gatewayv1alpha1.AiModelPolicyList
+	if err := v.Client.List(ctx, &policies, client.InNamespace(aiGateway.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AiModelPolicy resources: %w", err)
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+
+	models, err := v.gatewayModels(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	var allErrs field.ErrorList
+	for _, model := range models {
+		for _, policy := range policies.Items {
+			if !policy.Allows(model.AiModel) {
+				allErrs = append(allErrs, field.Forbidden(model.path,
+					fmt.Sprintf("model %q is not allowed by AiModelPolicy %q", model.QualifiedName(),
+						policy.Name)))
+			}
+		}
+	}
+	return allErrs, nil
+}
+
+// validateAllowedProviders ensures every model of the AiGateway uses a provider allowed by its AiGatewayClass.
+// Like AiModelPolicies, it does not apply to models imported via importConfigRef.
+func (v *AiGatewayCustomValidator) validateAllowedProviders(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (field.ErrorList, error) {
+	aiGatewayClass, err := v.gatewayClass(ctx, aiGateway)
+	if err != nil || aiGatewayClass == nil {
+		return nil, err
+	}
+	className := aiGatewayClass.Name
+	allowed := aiGatewayClass.Spec.AllowedProviders
+	if len(allowed) == 0 {
+		return nil, nil
+	}
+
+	models, err := v.gatewayModels(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	var allErrs field.ErrorList
+	for _, model := range models {
+		if !slices.Contains(allowed, model.Provider) {
+			allErrs = append(allErrs, field.Forbidden(model.path,
+				fmt.Sprintf("provider %q of model %q is not allowed by AiGatewayClass %q, allowed providers are %s",
+					model.Provider, model.Name, className, strings.Join(allowed, ", "))))
+		}
+	}
+	return allErrs, nil
+}
+
+// validateMaxModels ensures the AiGateway has at most the maxModelsPerGateway of its AiGatewayClass models,
+// counting the models imported from model libraries and inherited from baselines.
+func (v *AiGatewayCustomValidator) validateMaxModels(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (field.ErrorList, error) {
+	aiGatewayClass, err := v.gatewayClass(ctx, aiGateway)
+	if err != nil || aiGatewayClass == nil || aiGatewayClass.Spec.MaxModelsPerGateway == nil {
+		return nil, err
+	}
+	limit := *aiGatewayClass.Spec.MaxModelsPerGateway
+
+	models, err := v.gatewayModels(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	if len(models) <= int(limit) {
+		return nil, nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "aiModels"),
+		fmt.Sprintf("the gateway has %d models including imported and inherited models, "+
+			"AiGatewayClass %q allows at most %d (spec.maxModelsPerGateway)", len(models), aiGatewayClass.Name, limit),
+	)}, nil
+}
+
+// gatewayClass returns the AiGatewayClass of the AiGateway, see gatewayv1alpha1.ResolveClass, or nil if it has
+// none or the class does not exist. A missing class is not an error here, as the AiGateway may be created before
+// its class.
+func (v *AiGatewayCustomValidator) gatewayClass(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (*gatewayv1alpha1.AiGatewayClass, error) {
+	className := aiGateway.Spec.AiGatewayClassName
+	if className == "" {
+		return nil, nil
+	}
+	var aiGatewayClasses gatewayv1alpha1.AiGatewayClassList
+	if err := v.Client.List(ctx, &aiGatewayClasses, client.InNamespace(v.ClassNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AiGatewayClass resources: %w", err)
+	}
+	return gatewayv1alpha1.ResolveClass(aiGatewayClasses.Items, className, v.ClassNamespace), nil
+}
+
+// gatewayModel is a model served by an AiGateway together with the field that configures it.
+type gatewayModel struct {
+	gatewayv1alpha1.AiModel
+	path *field.Path
+}
+
+// gatewayModels returns the models of the AiGateway as render.Renderer.Effective merges them: its own models,
+// the models imported from model libraries and the models inherited from its baselines that are not overridden
+// by a model of the same public name. Missing libraries and baselines are skipped, they are reported by
+// validateModelLibraries and validateExtends.
+func (v *AiGatewayCustomValidator) gatewayModels(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) ([]gatewayModel, error) {
+	var models []gatewayModel
+	for i, model := range aiGateway.Spec.AiModels {
+		models = append(models, gatewayModel{AiModel: model, path: field.NewPath("spec", "aiModels").Index(i)})
+	}
+	// overridden reports whether one of the models replaces model, as MergeModels does.
+	overridden := func(models []gatewayModel, model gatewayv1alpha1.AiModel) bool {
+		return slices.ContainsFunc(models, func(other gatewayModel) bool {
+			return other.PublicName() == model.PublicName()
+		})
+	}
+	own := len(models)
+
+	for i, library := range aiGateway.Spec.ModelLibraries {
+		var modelLibrary gatewayv1alpha1.AiModelLibrary
+		if err := v.Client.Get(ctx, client.ObjectKey{Name: library.Name}, &modelLibrary); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get AiModelLibrary %s: %w", library.Name, err)
+		}
+		for _, model := range modelLibrary.Select(library.Models) {
+			if !overridden(models[:own], model) {
+				models = append(models, gatewayModel{AiModel: model,
+					path: field.NewPath("spec", "modelLibraries").Index(i)})
+			}
+		}
+	}
+
+	inherited, _, err := v.resolveBaselines(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	for _, model := range inherited {
+		if !overridden(models, model) {
+			models = append(models, gatewayModel{AiModel: model, path: field.NewPath("spec", "extends")})
+		}
+	}
+	return models, nil
+}
+
+// validateExtends ensures the baselines the AiGateway builds on exist and form no cycle.
+func (v *AiGatewayCustomValidator) validateExtends(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (field.ErrorList, error) {
+	_, fieldErr, err := v.resolveBaselines(ctx, aiGateway)
+	if err != nil || fieldErr == nil {
+		return nil, err
+	}
+	return field.ErrorList{fieldErr}, nil
+}
+
+// resolveBaselines walks the spec.extends chain of the AiGateway and returns the models it inherits.
+// A missing baseline, a cycle or a too long chain is returned as field error.
+func (v *AiGatewayCustomValidator) resolveBaselines(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) ([]gatewayv1alpha1.AiModel, *field.Error, error) {
+	extendsPath := field.NewPath("spec", "extends")
+	visited := map[types.NamespacedName]bool{{Namespace: aiGateway.Namespace, Name: aiGateway.Name}: true}
+	namespace := aiGateway.Namespace
+	var chain [][]gatewayv1alpha1.AiModel
+
+	for ref, depth := aiGateway.Spec.Extends, 1; ref != nil && ref.Name != ""; depth++ {
+		if depth > gatewayv1alpha1.MaxExtendsDepth {
+			return nil, field.Invalid(extendsPath, ref.Name,
+				fmt.Sprintf("more than %d baselines are not supported", gatewayv1alpha1.MaxExtendsDepth)), nil
+		}
+
+		if ref.Kind == gatewayv1alpha1.ExtendsKindAiModelLibrary {
+			var library gatewayv1alpha1.AiModelLibrary
+			if err := v.Client.Get(ctx, client.ObjectKey{Name: ref.Name}, &library); apierrors.IsNotFound(err) {
+				return nil, field.NotFound(extendsPath, "AiModelLibrary "+ref.Name), nil
+			} else if err != nil {
+				return nil, nil, fmt.Errorf("failed to get AiModelLibrary %s: %w", ref.Name, err)
+			}
+			chain = append(chain, library.Spec.Models)
+			break
+		}
+
+		extending := namespace
+		if ref.Namespace != "" {
+			namespace = ref.Namespace
+		}
+		key := types.NamespacedName{Namespace: namespace, Name: ref.Name}
+		if visited[key] {
+			return nil, field.Invalid(extendsPath, key.String(), "baselines must not form a cycle"), nil
+		}
+		visited[key] = true
+
+		var base gatewayv1alpha1.AiGateway
+		if err := v.Client.Get(ctx, key, &base); apierrors.IsNotFound(err) {
+			return nil, field.NotFound(extendsPath, "AiGateway "+key.String()), nil
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to get AiGateway %s: %w", key, err)
+		}
+		if !base.ExtendableFrom(extending) {
+			return nil, field.Forbidden(extendsPath, fmt.Sprintf("AiGateway %s cannot be extended from namespace "+
+				"%q; list it in the %s annotation of the baseline", key, extending,
+				gatewayv1alpha1.ExtendableFromAnnotation)), nil
+		}
+		chain = append(chain, base.Spec.AiModels)
+		ref = base.Spec.Extends
+	}
+
+	var inherited []gatewayv1alpha1.AiModel
+	for i := len(chain) - 1; i >= 0; i-- {
+		inherited = gatewayv1alpha1.MergeModels(inherited, chain[i])
+	}
+	return inherited, nil, nil
+}
+
+// validateModelLibraries ensures the AiModelLibraries imported by the AiGateway exist and contain the selected models.
+func (v *AiGatewayCustomValidator) validateModelLibraries(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (field.ErrorList, error) {
+	var allErrs field.ErrorList
+	for i, library := range aiGateway.Spec.ModelLibraries {
+		libraryPath := field.NewPath("spec", "modelLibraries").Index(i)
+		if library.Name == "" {
+			continue
+		}
+		var modelLibrary gatewayv1alpha1.AiModelLibrary
+		switch err := v.Client.Get(ctx, client.ObjectKey{Name: library.Name}, &modelLibrary); {
+		case apierrors.IsNotFound(err):
+			allErrs = append(allErrs, field.NotFound(libraryPath.Child("name"), library.Name))
+			continue
+		case err != nil:
+			return nil, fmt.Errorf("failed to get AiModelLibrary %s: %w", library.Name, err)
+		}
+		for j, name := range library.Models {
+			if !slices.ContainsFunc(modelLibrary.Spec.Models, func(model gatewayv1alpha1.AiModel) bool {
+				return model.PublicName() == name
+			}) {
+				allErrs = append(allErrs, field.NotFound(libraryPath.Child("models").Index(j), name))
+			}
+		}
+	}
+	return allErrs, nil
+}
+
+// validateQuotas ensures that admitting the AiGateway does not exceed an AiGatewayQuota in its namespace.
+func (v *AiGatewayCustomValidator) validateQuotas(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (field.ErrorList, error) {
+	var quotas gatewayv1alpha1.AiGatewayQuotaList
+	if err := v.Client.List(ctx, &quotas, client.InNamespace(aiGateway.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AiGatewayQuota resources: %w", err)
+	}
+	if len(quotas.Items) == 0 {
+		return nil, nil
+	}
+
+	var aiGateways gatewayv1alpha1.AiGatewayList
+	if err := v.Client.List(ctx, &aiGateways, client.InNamespace(aiGateway.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list AiGateway resources: %w", err)
+	}
+	before, usage, err := v.quotaUsage(ctx, aiGateways.Items, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+
+	var allErrs field.ErrorList
+	for _, quota := range quotas.Items {
+		for _, limit := range []struct {
+			path      *field.Path
+			name      string
+			used, was int32
+			limit     *int32
+		}{
+			{field.NewPath("metadata", "name"), "AiGateways", usage.Gateways, before.Gateways, quota.Spec.MaxGateways},
+			{field.NewPath("spec", "aiModels"), "models", usage.Models, before.Models, quota.Spec.MaxModels},
+			{field.NewPath("spec", "replicas"), "replicas", usage.Replicas, before.Replicas, quota.Spec.MaxReplicas},
+		} {
+			// Namespaces already over a limit, e.g. because it was lowered or a baseline gained models, may keep
+			// changing their gateways as long as the usage doesn't grow.
+			if limit.limit != nil && limit.used > *limit.limit && limit.used > limit.was {
+				allErrs = append(allErrs, field.Forbidden(limit.path,
+					fmt.Sprintf("exceeds AiGatewayQuota %q: %d %s in namespace, limit is %d",
+						quota.Name, limit.used, limit.name, *limit.limit)))
+			}
+		}
+	}
+	return allErrs, nil
+}
+
+// quotaUsage returns the usage of the existing AiGateways before and after admitting aiGateway, which replaces
+// the existing AiGateway of the same name on updates. Models are counted as implementations serve them, including
+// the models imported from model libraries and inherited from baselines, see gatewayModels.
+func (v *AiGatewayCustomValidator) quotaUsage(ctx context.Context, aiGateways []gatewayv1alpha1.AiGateway,
+	aiGateway *gatewayv1alpha1.AiGateway) (before, after gatewayv1alpha1.AiGatewayQuotaUsage, err error) {
+	count := func(usage *gatewayv1alpha1.AiGatewayQuotaUsage, gateway *gatewayv1alpha1.AiGateway) error {
+		models, err := v.gatewayModels(ctx, gateway)
+		if err != nil {
+			return err
+		}
+		usage.Gateways++
+		usage.Models += int32(len(models))
+		usage.Replicas += gateway.Spec.MaxReplicas()
+		return nil
+	}
+
+	if err := count(&after, aiGateway); err != nil {
+		return before, after, err
+	}
+	for i := range aiGateways {
+		if aiGateways[i].Name == aiGateway.Name {
+			// The gateway being updated, replaced by aiGateway.
+			if err := count(&before, &aiGateways[i]); err != nil {
+				return before, after, err
+			}
+			continue
+		}
+		var other gatewayv1alpha1.AiGatewayQuotaUsage
+		if err := count(&other, &aiGateways[i]); err != nil {
+			return before, after, err
+		}
+		for _, usage := range []*gatewayv1alpha1.AiGatewayQuotaUsage{&before, &after} {
+			usage.Gateways += other.Gateways
+			usage.Models += other.Models
+			usage.Replicas += other.Replicas
+		}
+	}
+	return before, after, nil
+}
+
+// validateAiGatewaySpec contains the validation logic for the AiGateway spec that needs no cluster state.
+func validateAiGatewaySpec(aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	specPath := field.NewPath("spec")
+
 	// Validate port is positive
 	if aiGateway.Spec.Port <= 0 {
-		return nil, fmt.Errorf("aiGateway port must be positive, got: %d", aiGateway.Spec.Port)
+		allErrs = append(allErrs, field.Invalid(specPath.Child("port"), aiGateway.Spec.Port,
+			"aiGateway port must be positive"))
+	}
+	allErrs = append(allErrs, validateListenerPorts(specPath, &aiGateway.Spec)...)
+
+	allErrs = append(allErrs, validateModelCatalog(specPath, &aiGateway.Spec)...)
+
+	if aiGateway.Spec.PromptPolicy != nil {
+		allErrs = append(allErrs, validatePromptPolicy(specPath.Child("promptPolicy"), aiGateway.Spec.PromptPolicy)...)
+	}
+
+	if aiGateway.Spec.Observability != nil {
+		allErrs = append(allErrs, validateObservabilityConfig(specPath.Child("observability"),
+			aiGateway.Spec.Observability)...)
+	}
+
+	if caching := aiGateway.Spec.Caching; caching != nil && caching.OptOut != nil {
+		allErrs = append(allErrs, validateConsumerSelector(specPath.Child("caching", "optOut"), *caching.OptOut)...)
+		if !caching.Enabled {
+			warnings = append(warnings, "spec.caching.optOut has no effect because caching is not enabled")
+		}
+	}
+
+	if aiGateway.Spec.Routing != nil {
+		allErrs = append(allErrs, validateRoutingConfig(specPath.Child("routing"), aiGateway.Spec.Routing,
+			knownNames(&aiGateway.Spec))...)
+	}
+
+	if database := aiGateway.Spec.Database; database != nil {
+		allErrs = append(allErrs, validateKeyRef(specPath.Child("database", "secretRef"),
+			database.SecretRef.Name, database.SecretRef.Key)...)
+	}
+
+	if auth := aiGateway.Spec.Auth; auth != nil && auth.JWT != nil {
+		allErrs = append(allErrs, validateJWTAuth(specPath.Child("auth", "jwt"), auth.JWT)...)
+	}
+
+	if ref := aiGateway.Spec.MasterKeySecretRef; ref != nil {
+		allErrs = append(allErrs, validateKeyRef(specPath.Child("masterKeySecretRef"), ref.Name, ref.Key)...)
+		if _, ok := aiGateway.Annotations[gatewayv1alpha1.RotateMasterKeyAnnotation]; ok {
+			warnings = append(warnings, fmt.Sprintf("annotation %s has no effect because spec.masterKeySecretRef "+
+				"references an existing master key, which must be rotated by its owner",
+				gatewayv1alpha1.RotateMasterKeyAnnotation))
+		}
+	}
+
+	if aiGateway.Spec.Egress != nil {
+		allErrs = append(allErrs, validateEgressConfig(specPath.Child("egress"), aiGateway.Spec.Egress)...)
+	}
+
+	if aiGateway.Spec.Logging != nil {
+		loggingWarnings, loggingErrs := validateLoggingConfig(specPath.Child("logging"), aiGateway.Spec.Logging)
+		warnings = append(warnings, loggingWarnings...)
+		allErrs = append(allErrs, loggingErrs...)
+	}
+
+	if aiGateway.Spec.Audit != nil {
+		allErrs = append(allErrs, validateAuditConfig(specPath.Child("audit"), aiGateway.Spec.Audit)...)
+	}
+
+	allErrs = append(allErrs, validateIntegrations(specPath, &aiGateway.Spec)...)
+
+	if aiGateway.Spec.Streaming != nil {
+		allErrs = append(allErrs, validateStreamingConfig(specPath.Child("streaming"), aiGateway.Spec.Streaming)...)
+	}
+
+	if aiGateway.Spec.Resilience != nil && aiGateway.Spec.Resilience.CircuitBreaker != nil {
+		allErrs = append(allErrs, validateCircuitBreaker(specPath.Child("resilience", "circuitBreaker"),
+			aiGateway.Spec.Resilience.CircuitBreaker)...)
+	}
+
+	if aiGateway.Spec.RequestLimits != nil {
+		allErrs = append(allErrs, validateRequestTokenLimits(specPath.Child("requestLimits"),
+			aiGateway.Spec.RequestLimits)...)
+	}
+
+	workloadWarnings, workloadErrs := validateWorkload(specPath, aiGateway)
+	warnings = append(warnings, workloadWarnings...)
+	allErrs = append(allErrs, workloadErrs...)
+
+	return warnings, allErrs
+}
+
+// validateIntegrations validates the hooks, tool servers, protocols and discovery settings that connect
+// the gateway to the rest of the agentic layer.
+func validateIntegrations(specPath *field.Path, spec *gatewayv1alpha1.AiGatewaySpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if hooks := spec.Hooks; hooks != nil {
+		allErrs = append(allErrs, validateHookEndpoint(specPath.Child("hooks", "preCall"), hooks.PreCall)...)
+		allErrs = append(allErrs, validateHookEndpoint(specPath.Child("hooks", "postCall"), hooks.PostCall)...)
+	}
+
+	allErrs = append(allErrs, validateMCPServers(specPath.Child("mcpServers"), spec.MCPServers)...)
+
+	if settings := spec.SettingsFrom; settings != nil && settings.ConfigMapRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("settingsFrom", "configMapRef", "name"),
+			"name cannot be empty"))
+	}
+	if ref := spec.SecretProviderClassRef; ref != nil {
+		allErrs = append(allErrs, validateSecretProviderClassRef(specPath.Child("secretProviderClassRef"), ref)...)
+	}
+
+	if protocols := spec.Protocols; protocols != nil && protocols.A2A != nil {
+		allErrs = append(allErrs, validateA2AProtocol(specPath.Child("protocols", "a2a"), protocols.A2A,
+			knownNames(spec))...)
+	}
+
+	if discovery := spec.Discovery; discovery != nil && discovery.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(discovery.NamespaceSelector,
+			metav1validation.LabelSelectorValidationOptions{}, specPath.Child("discovery", "namespaceSelector"))...)
+	}
+
+	return allErrs
+}
+
+// validateModelCatalog validates the models of the gateway and the public names and mirrors built on top of them.
+func validateModelCatalog(specPath *field.Path, spec *gatewayv1alpha1.AiGatewaySpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// Validate at least one AI model is specified, unless the models are imported from an existing configuration,
+	// from model libraries or from a baseline
+	if spec.ImportConfigRef != nil {
+		allErrs = append(allErrs, validateContentSource(specPath.Child("importConfigRef"), *spec.ImportConfigRef)...)
+	} else if len(spec.AiModels) == 0 && len(spec.ModelLibraries) == 0 && spec.Extends == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("aiModels"), "no AI models specified in AiGateway"))
 	}
 
-	// Validate at least one AI model is specified
-	if len(aiGateway.Spec.AiModels) == 0 {
-		return nil, errors.New("no AI models specified in AiGateway")
+	if ref := spec.Extends; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("extends", "name"), "name cannot be empty"))
+		}
+		if ref.Kind == gatewayv1alpha1.ExtendsKindAiModelLibrary && ref.Namespace != "" {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("extends", "namespace"),
+				"AiModelLibraries are cluster-scoped"))
+		}
+	}
+
+	libraries := make(map[string]int)
+	for i, library := range spec.ModelLibraries {
+		libraryPath := specPath.Child("modelLibraries").Index(i)
+		if library.Name == "" {
+			allErrs = append(allErrs, field.Required(libraryPath.Child("name"), "name cannot be empty"))
+		} else if j, exists := libraries[library.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(libraryPath.Child("name"),
+				fmt.Sprintf("%s (already imported by spec.modelLibraries[%d])", library.Name, j)))
+		} else {
+			libraries[library.Name] = i
+		}
 	}
 
 	// Validate AI models
-	for _, model := range aiGateway.Spec.AiModels {
-		if model.Name == "" {
-			return nil, errors.New("AI model name cannot be empty")
+	for i, model := range spec.AiModels {
+		allErrs = append(allErrs, validateAiModel(specPath.Child("aiModels").Index(i), model)...)
+	}
+	allErrs = append(allErrs, validateModelAliases(specPath.Child("aiModels"), spec.AiModels)...)
+	allErrs = append(allErrs, validateModelDuplicates(specPath.Child("aiModels"), spec.AiModels)...)
+	for i, model := range spec.AiModels {
+		if model.Mirror != nil {
+			allErrs = append(allErrs, validateMirrorConfig(specPath.Child("aiModels").Index(i).Child("mirror"),
+				model.Mirror, model.PublicName(), knownNames(spec))...)
+		}
+	}
+
+	for i, split := range spec.TrafficSplits {
+		allErrs = append(allErrs, validateTrafficSplit(specPath.Child("trafficSplits"), i, split,
+			spec.TrafficSplits, knownNames(spec))...)
+	}
+	allErrs = append(allErrs, validateModelGroups(specPath.Child("modelGroups"), spec)...)
+
+	return allErrs
+}
+
+// validateModelGroups validates that the names of the model groups are unique public names and that the
+// groups only reference existing models.
+func validateModelGroups(fldPath *field.Path, spec *gatewayv1alpha1.AiGatewaySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	names := knownNames(spec)
+	groups := make(map[string]int)
+	for i, group := range spec.ModelGroups {
+		groupPath := fldPath.Index(i)
+		if group.Name == "" {
+			allErrs = append(allErrs, field.Required(groupPath.Child("name"), "name cannot be empty"))
+		} else if j, exists := groups[group.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(groupPath.Child("name"),
+				fmt.Sprintf("%s (already used by %s)", group.Name, fldPath.Index(j))))
+		} else {
+			groups[group.Name] = i
+		}
+		// Collisions with traffic split aliases are reported on the traffic split
+		if slices.ContainsFunc(spec.AiModels, func(model gatewayv1alpha1.AiModel) bool {
+			return model.PublicName() == group.Name
+		}) {
+			allErrs = append(allErrs, field.Invalid(groupPath.Child("name"), group.Name,
+				"name collides with the public name of a model"))
+		}
+
+		if len(group.Models) == 0 {
+			allErrs = append(allErrs, field.Required(groupPath.Child("models"), "at least one model is required"))
+		}
+		seen := make(map[string]bool)
+		for j, model := range group.Models {
+			modelPath := groupPath.Child("models").Index(j)
+			switch {
+			case model == "":
+				allErrs = append(allErrs, field.Required(modelPath, "model cannot be empty"))
+			case seen[model]:
+				allErrs = append(allErrs, field.Duplicate(modelPath, model))
+			case slices.ContainsFunc(spec.ModelGroups, func(other gatewayv1alpha1.ModelGroup) bool {
+				return other.Name == model
+			}):
+				allErrs = append(allErrs, field.Invalid(modelPath, model, "model groups cannot be nested"))
+			case !hasModel(names, model):
+				allErrs = append(allErrs, field.NotFound(modelPath, model))
+			}
+			seen[model] = true
+		}
+
+		for j, fallback := range group.Fallbacks {
+			fallbackPath := groupPath.Child("fallbacks").Index(j)
+			switch {
+			case fallback == group.Name:
+				allErrs = append(allErrs, field.Invalid(fallbackPath, fallback, "a group cannot fall back to itself"))
+			case !hasModel(names, fallback):
+				allErrs = append(allErrs, field.NotFound(fallbackPath, fallback))
+			}
 		}
 
-		if model.Provider == "" {
-			return nil, errors.New("AI model provider cannot be empty")
+		if group.RateLimits != nil {
+			allErrs = append(allErrs, validateRateLimits(groupPath.Child("rateLimits"), *group.RateLimits)...)
 		}
+	}
+	return allErrs
+}
 
-		// The implementation operator will handle provider-specific configuration
-		// and validate the actual model availability at runtime.
+// validateWorkload validates the settings of the generated gateway Deployment and its pods.
+func validateWorkload(specPath *field.Path, aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, field.ErrorList) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+
+	if redisRef := aiGateway.Spec.RedisRef; redisRef != nil {
+		allErrs = append(allErrs, validateKeyRef(specPath.Child("redisRef"), redisRef.Name, redisRef.Key)...)
+	} else if aiGateway.Spec.MaxReplicas() > 1 {
+		warnings = append(warnings, "spec.redisRef is not set, so each of the gateway replicas "+
+			"enforces rate limits and caches responses on its own")
 	}
 
-	return nil, nil
+	if autoscaling := aiGateway.Spec.Autoscaling; autoscaling != nil {
+		allErrs = append(allErrs, validateAutoscalingConfig(specPath.Child("autoscaling"), autoscaling)...)
+	}
+	if scaleToZero := aiGateway.Spec.ScaleToZero; scaleToZero != nil {
+		allErrs = append(allErrs, validateScaleToZeroConfig(specPath.Child("scaleToZero"), scaleToZero)...)
+	}
+	allErrs = append(allErrs, validateSchedules(specPath.Child("schedules"), &aiGateway.Spec)...)
+
+	if rollout := aiGateway.Spec.Rollout; rollout != nil && rollout.Canary != nil {
+		allErrs = append(allErrs, validateCanaryRollout(specPath.Child("rollout", "canary"), rollout.Canary)...)
+	}
+
+	allErrs = append(allErrs, validatePodClassNames(specPath, aiGateway.Spec)...)
+	if template := aiGateway.Spec.Template; template != nil {
+		metadataPath := specPath.Child("template", "metadata")
+		allErrs = append(allErrs, metav1validation.ValidateLabels(template.Metadata.Labels,
+			metadataPath.Child("labels"))...)
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(template.Metadata.Annotations,
+			metadataPath.Child("annotations"))...)
+	}
+	if aiGateway.Spec.Probes != nil {
+		allErrs = append(allErrs, validateProbesConfig(specPath.Child("probes"), aiGateway.Spec.Probes)...)
+	}
+	if aiGateway.Spec.UpdateStrategy != nil {
+		allErrs = append(allErrs, validateUpdateStrategy(specPath.Child("updateStrategy"),
+			aiGateway.Spec.UpdateStrategy)...)
+	}
+
+	serviceAccount := aiGateway.Spec.ServiceAccount
+	if serviceAccount != nil && serviceAccount.Name != "" && len(serviceAccount.Annotations) > 0 {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("serviceAccount", "annotations"),
+			"annotations can only be set on a generated ServiceAccount, not together with name"))
+	}
+	warnings = append(warnings, workloadIdentityWarnings(aiGateway)...)
+
+	return warnings, allErrs
+}
+
+// validateAiModel validates a single AI model entry.
+// The implementation operator will handle provider-specific configuration
+// and validate the actual model availability at runtime.
+func validateAiModel(fldPath *field.Path, model gatewayv1alpha1.AiModel) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if model.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "AI model name cannot be empty"))
+	} else {
+		allErrs = append(allErrs, validateModelName(fldPath.Child("name"), model.Name)...)
+	}
+
+	if model.IsWildcard() && model.Alias != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("alias"), "wildcard models cannot have an alias"))
+	}
+
+	if model.Provider == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("provider"), "AI model provider cannot be empty"))
+	}
+
+	allErrs = append(allErrs, validateProviderConfig(fldPath, model)...)
+	allErrs = append(allErrs, validateModelEndpoint(fldPath, model)...)
+	if ref := model.APIKeySecretRef; ref != nil {
+		allErrs = append(allErrs, validateKeyRef(fldPath.Child("apiKeySecretRef"), ref.Name, ref.Key)...)
+	}
+
+	if model.PromptPolicy != nil {
+		allErrs = append(allErrs, validatePromptPolicy(fldPath.Child("promptPolicy"), model.PromptPolicy)...)
+	}
+
+	if limit := model.MaxParallelRequests; limit != nil && *limit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelRequests"), *limit, "must be at least 1"))
+	}
+	if cooldown := model.CooldownSeconds; cooldown != nil && *cooldown < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cooldownSeconds"), *cooldown, "must not be negative"))
+	}
+	if model.RequestLimits != nil {
+		allErrs = append(allErrs, validateRequestTokenLimits(fldPath.Child("requestLimits"), model.RequestLimits)...)
+	}
+	if capabilities := model.Capabilities; capabilities != nil && capabilities.ContextWindow > 0 &&
+		capabilities.MaxOutputTokens > capabilities.ContextWindow {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("capabilities", "maxOutputTokens"),
+			capabilities.MaxOutputTokens, "must not exceed the context window"))
+	}
+
+	if model.Guardrails != nil {
+		for i, exemption := range model.Guardrails.Exemptions {
+			allErrs = append(allErrs, validateGuardrailExemption(
+				fldPath.Child("guardrails", "exemptions").Index(i), exemption)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateModelName checks a model name for malformed input. A "*" is only allowed as the last character,
+// where it configures wildcard routing to all provider models matching the prefix.
+func validateModelName(fldPath *field.Path, name string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if strings.ContainsFunc(name, unicode.IsSpace) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "AI model name must not contain whitespace"))
+	}
+	if i := strings.Index(name, "*"); i >= 0 && i != len(name)-1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, name,
+			"wildcard '*' is only allowed as the last character of an AI model name"))
+	}
+
+	return allErrs
+}
+
+// validateModelAliases ensures aliases do not shadow the name of another model and are unique, except among
+// deployments of the same model: AiModel.Normalize keeps the name of a model as its alias, e.g. "gpt-4o" for an
+// OpenAI and an Azure deployment of gpt-4o the gateway balances requests across.
+func validateModelAliases(fldPath *field.Path, models []gatewayv1alpha1.AiModel) field.ErrorList {
+	var allErrs field.ErrorList
+
+	aliases := make(map[string]int)
+	for i, model := range models {
+		if model.Alias == "" {
+			continue
+		}
+		aliasPath := fldPath.Index(i).Child("alias")
+		if j, exists := aliases[model.Alias]; exists {
+			if models[j].ProviderModelName() != model.ProviderModelName() {
+				allErrs = append(allErrs, field.Duplicate(aliasPath,
+					fmt.Sprintf("%s (already used by spec.aiModels[%d])", model.Alias, j)))
+			}
+			continue
+		}
+		aliases[model.Alias] = i
+
+		for j, other := range models {
+			if j != i && other.Name == model.Alias && other.Alias == "" {
+				allErrs = append(allErrs, field.Invalid(aliasPath, model.Alias,
+					fmt.Sprintf("alias collides with the name of spec.aiModels[%d]", j)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateModelDuplicates ensures no model is listed twice. Models sharing a public name are deployments the
+// gateway balances requests across, so they must differ in their provider, name or endpoint.
+func validateModelDuplicates(fldPath *field.Path, models []gatewayv1alpha1.AiModel) field.ErrorList {
+	var allErrs field.ErrorList
+	deployment := func(model gatewayv1alpha1.AiModel) gatewayv1alpha1.AiModel {
+		return gatewayv1alpha1.AiModel{
+			Name:       model.Name,
+			Provider:   model.Provider,
+			Alias:      model.Alias,
+			APIBase:    model.APIBase,
+			BackendRef: model.BackendRef,
+			Azure:      model.Azure,
+			Bedrock:    model.Bedrock,
+			VertexAI:   model.VertexAI,
+		}
+	}
+
+	for i, model := range models {
+		if j := slices.IndexFunc(models[:i], func(other gatewayv1alpha1.AiModel) bool {
+			return equality.Semantic.DeepEqual(deployment(model), deployment(other))
+		}); j >= 0 {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i),
+				fmt.Sprintf("%s/%s (same deployment as %s)", model.Provider, model.Name, fldPath.Index(j))))
+		}
+	}
+	return allErrs
+}
+
+// validateProviderConfig validates the provider-specific configuration blocks of an AI model.
+func validateProviderConfig(fldPath *field.Path, model gatewayv1alpha1.AiModel) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if model.Azure != nil {
+		if err := validateProviderBlock(fldPath.Child("azure"), model.Provider, azureProvider); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validateAzureConfig(fldPath.Child("azure"), model.Azure)...)
+	}
+
+	if model.Bedrock != nil {
+		if err := validateProviderBlock(fldPath.Child("bedrock"), model.Provider, bedrockProvider); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if !awsRegionPattern.MatchString(model.Bedrock.Region) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bedrock", "region"), model.Bedrock.Region,
+				"must be a valid AWS region (e.g. eu-central-1)"))
+		}
+	}
+
+	if model.VertexAI != nil {
+		if err := validateProviderBlock(fldPath.Child("vertexAI"), model.Provider, vertexAIProvider); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validateVertexAIConfig(fldPath.Child("vertexAI"), model.VertexAI)...)
+	} else if model.Provider == vertexAIProvider {
+		allErrs = append(allErrs, field.Required(fldPath.Child("vertexAI"),
+			fmt.Sprintf("vertexAI configuration is required for provider %q", vertexAIProvider)))
+	}
+
+	return allErrs
+}
+
+// validateModelEndpoint validates how the endpoint of an AI model is determined.
+// A model can use at most one of apiBase, backendRef or the API base of its azure configuration.
+func validateModelEndpoint(fldPath *field.Path, model gatewayv1alpha1.AiModel) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if model.APIBase != "" {
+		if err := validateURL(fldPath.Child("apiBase"), model.APIBase, "http", "https"); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if model.Azure != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("apiBase"),
+				"apiBase cannot be combined with an azure configuration, use azure.apiBase instead"))
+		}
+		if model.BackendRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("apiBase"),
+				"apiBase cannot be combined with backendRef"))
+		}
+	}
+
+	if model.BackendRef != nil {
+		allErrs = append(allErrs, validateBackendServiceRef(fldPath.Child("backendRef"), model.BackendRef)...)
+		if model.Azure != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("backendRef"),
+				"backendRef cannot be combined with an azure configuration"))
+		}
+	}
+
+	allErrs = append(allErrs, validateFailoverEndpoints(fldPath, model)...)
+
+	return allErrs
+}
+
+// validateFailoverEndpoints ensures the failover endpoints of an AI model have unique names and distinct,
+// valid API bases.
+func validateFailoverEndpoints(fldPath *field.Path, model gatewayv1alpha1.AiModel) field.ErrorList {
+	var allErrs field.ErrorList
+
+	schemes := []string{"http", "https"}
+	apiBases := map[string]string{}
+	if model.Azure != nil {
+		schemes = []string{"https"}
+		apiBases[model.Azure.APIBase] = fldPath.Child("azure", "apiBase").String()
+	} else if model.APIBase != "" {
+		apiBases[model.APIBase] = fldPath.Child("apiBase").String()
+	}
+
+	names := map[string]bool{}
+	for i, endpoint := range model.FailoverEndpoints {
+		endpointPath := fldPath.Child("failoverEndpoints").Index(i)
+		switch {
+		case endpoint.Name == gatewayv1alpha1.PrimaryEndpointName:
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("name"), endpoint.Name,
+				"the name is reserved for the primary endpoint"))
+		case names[endpoint.Name]:
+			allErrs = append(allErrs, field.Duplicate(endpointPath.Child("name"), endpoint.Name))
+		}
+		names[endpoint.Name] = true
+
+		if err := validateURL(endpointPath.Child("apiBase"), endpoint.APIBase, schemes...); err != nil {
+			allErrs = append(allErrs, err)
+		} else if other, exists := apiBases[endpoint.APIBase]; exists {
+			allErrs = append(allErrs, field.Invalid(endpointPath.Child("apiBase"), endpoint.APIBase,
+				fmt.Sprintf("already used by %s", other)))
+		} else {
+			apiBases[endpoint.APIBase] = endpointPath.Child("apiBase").String()
+		}
+	}
+
+	return allErrs
+}
+
+// validateProviderBlock ensures a provider-specific configuration block is only used with its provider.
+func validateProviderBlock(fldPath *field.Path, provider, expectedProvider string) *field.Error {
+	if provider != expectedProvider {
+		return field.Forbidden(fldPath, fmt.Sprintf("only allowed for provider %q", expectedProvider))
+	}
+	return nil
+}
+
+// validateAzureConfig validates the Azure OpenAI settings of an AI model.
+func validateAzureConfig(fldPath *field.Path, azure *gatewayv1alpha1.AzureConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if azure.APIBase == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiBase"), "Azure API base cannot be empty"))
+	} else if err := validateURL(fldPath.Child("apiBase"), azure.APIBase, "https"); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if azure.APIVersion == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiVersion"), "Azure API version cannot be empty"))
+	}
+
+	return allErrs
+}
+
+// validateGuardrailExemption validates that an exemption names guardrails, selects consumers and gives a reason.
+func validateGuardrailExemption(fldPath *field.Path, exemption gatewayv1alpha1.GuardrailExemption) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(exemption.Guardrails) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("guardrails"), "at least one guardrail must be listed"))
+	}
+	for i, guardrail := range exemption.Guardrails {
+		if guardrail == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("guardrails").Index(i), "guardrail name cannot be empty"))
+		}
+	}
+
+	allErrs = append(allErrs, validateConsumerSelector(fldPath.Child("consumers"), exemption.Consumers)...)
+
+	if exemption.Reason == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), "a reason is required for the audit log"))
+	}
+
+	return allErrs
+}
+
+// validateConsumerSelector checks that a consumer selector selects at least one consumer and has no empty entries.
+func validateConsumerSelector(fldPath *field.Path, selector gatewayv1alpha1.ConsumerSelector) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(selector.KeyAliases) == 0 && len(selector.Teams) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one key alias or team must be selected"))
+	}
+	for i, alias := range selector.KeyAliases {
+		if alias == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keyAliases").Index(i), "key alias cannot be empty"))
+		}
+	}
+	for i, team := range selector.Teams {
+		if team == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("teams").Index(i), "team cannot be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateVertexAIConfig validates the Vertex AI settings of an AI model.
+func validateVertexAIConfig(fldPath *field.Path, vertex *gatewayv1alpha1.VertexAIConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if vertex.Project == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("project"), "Vertex AI project cannot be empty"))
+	}
+	if vertex.Location == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), "Vertex AI location cannot be empty"))
+	}
+
+	switch vertex.CredentialsMode {
+	case gatewayv1alpha1.VertexCredentialsServiceAccountKey:
+		if vertex.CredentialsSecretRef == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("credentialsSecretRef"),
+				"a credentials Secret is required when using ServiceAccountKey credentials"))
+		} else {
+			allErrs = append(allErrs, validateKeyRef(fldPath.Child("credentialsSecretRef"),
+				vertex.CredentialsSecretRef.Name, vertex.CredentialsSecretRef.Key)...)
+		}
+	default:
+		if vertex.CredentialsSecretRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("credentialsSecretRef"),
+				"a credentials Secret can only be used with ServiceAccountKey credentials"))
+		}
+	}
+
+	return allErrs
+}
+
+// workloadIdentityWarnings warns about models relying on a cloud workload identity (IRSA on EKS, Workload
+// Identity on GKE) while the generated ServiceAccount lacks the annotation binding it to a cloud identity.
+// Such models will fail at runtime unless the implementation gets static credentials some other way.
+func workloadIdentityWarnings(aiGateway *gatewayv1alpha1.AiGateway) admission.Warnings {
+	serviceAccount := aiGateway.Spec.ServiceAccount
+	if serviceAccount != nil && serviceAccount.Name != "" {
+		// An existing ServiceAccount is used, its annotations are not managed by the gateway.
+		return nil
+	}
+	hasAnnotation := func(key string) bool {
+		return serviceAccount != nil && serviceAccount.Annotations[key] != ""
+	}
+
+	var warnings admission.Warnings
+	for _, model := range aiGateway.Spec.AiModels {
+		switch {
+		case model.Provider == bedrockProvider && !hasAnnotation(irsaRoleAnnotation):
+			warnings = append(warnings, fmt.Sprintf("AI model %q uses provider %q but spec.serviceAccount has no %q "+
+				"annotation; static AWS credentials will be required", model.Name, bedrockProvider, irsaRoleAnnotation))
+		case model.VertexAI != nil && usesWorkloadIdentity(model.VertexAI) && !hasAnnotation(workloadIdentityGSAAnnotation):
+			warnings = append(warnings, fmt.Sprintf("AI model %q uses Workload Identity but spec.serviceAccount has no %q "+
+				"annotation", model.Name, workloadIdentityGSAAnnotation))
+		}
+	}
+	return warnings
+}
+
+// usesWorkloadIdentity reports whether a Vertex AI model authenticates via Workload Identity (the default).
+func usesWorkloadIdentity(vertex *gatewayv1alpha1.VertexAIConfig) bool {
+	return vertex.CredentialsMode == "" || vertex.CredentialsMode == gatewayv1alpha1.VertexCredentialsWorkloadIdentity
+}
+
+// validateBackendServiceRef validates a reference to an in-cluster model backend.
+func validateBackendServiceRef(fldPath *field.Path, ref *gatewayv1alpha1.BackendServiceRef) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range validation.IsDNS1035Label(ref.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), ref.Name, msg))
+	}
+	if ref.Namespace != "" {
+		for _, msg := range validation.IsDNS1123Label(ref.Namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), ref.Namespace, msg))
+		}
+	}
+	for _, msg := range validation.IsValidPortNum(int(ref.Port)) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), ref.Port, msg))
+	}
+	if ref.Path != "" && !strings.HasPrefix(ref.Path, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), ref.Path, "must start with '/'"))
+	}
+
+	return allErrs
+}
+
+// validateObservabilityConfig validates the metrics, usage reporting and callback settings.
+func validateObservabilityConfig(fldPath *field.Path, observability *gatewayv1alpha1.ObservabilityConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if observability.Metrics != nil {
+		allErrs = append(allErrs, validateMetricsConfig(fldPath.Child("metrics"), observability.Metrics)...)
+	}
+	for i, callback := range observability.Callbacks {
+		allErrs = append(allErrs, validateCallbackConfig(fldPath.Child("callbacks").Index(i), callback)...)
+	}
+	if usage := observability.Usage; usage != nil {
+		if usage.Window != nil && usage.Window.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("usage", "window"), usage.Window.Duration.String(),
+				"must be positive"))
+		}
+		if usage.Interval != nil && usage.Interval.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("usage", "interval"), usage.Interval.Duration.String(),
+				"must be positive"))
+		}
+		if usage.Window != nil && usage.Interval != nil && usage.Interval.Duration > usage.Window.Duration {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("usage", "interval"), usage.Interval.Duration.String(),
+				"must not exceed the window"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCallbackConfig ensures exactly the block matching the callback type is set and complete.
+func validateCallbackConfig(fldPath *field.Path, callback gatewayv1alpha1.CallbackConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	blocks := []struct {
+		name     string
+		callType gatewayv1alpha1.CallbackType
+		set      bool
+	}{
+		{"langfuse", gatewayv1alpha1.CallbackTypeLangfuse, callback.Langfuse != nil},
+		{"helicone", gatewayv1alpha1.CallbackTypeHelicone, callback.Helicone != nil},
+		{"webhook", gatewayv1alpha1.CallbackTypeWebhook, callback.Webhook != nil},
+	}
+	for _, block := range blocks {
+		switch {
+		case block.callType == callback.Type && !block.set:
+			allErrs = append(allErrs, field.Required(fldPath.Child(block.name),
+				fmt.Sprintf("required for type %q", callback.Type)))
+		case block.callType != callback.Type && block.set:
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(block.name),
+				fmt.Sprintf("only allowed for type %q", block.callType)))
+		}
+	}
+
+	if langfuse := callback.Langfuse; langfuse != nil {
+		langfusePath := fldPath.Child("langfuse")
+		if langfuse.Host != "" {
+			if err := validateURL(langfusePath.Child("host"), langfuse.Host, "http", "https"); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		}
+		allErrs = append(allErrs, validateKeyRef(langfusePath.Child("publicKeySecretRef"),
+			langfuse.PublicKeySecretRef.Name, langfuse.PublicKeySecretRef.Key)...)
+		allErrs = append(allErrs, validateKeyRef(langfusePath.Child("secretKeySecretRef"),
+			langfuse.SecretKeySecretRef.Name, langfuse.SecretKeySecretRef.Key)...)
+	}
+	if helicone := callback.Helicone; helicone != nil {
+		allErrs = append(allErrs, validateKeyRef(fldPath.Child("helicone", "apiKeySecretRef"),
+			helicone.APIKeySecretRef.Name, helicone.APIKeySecretRef.Key)...)
+	}
+	if webhook := callback.Webhook; webhook != nil {
+		if err := validateURL(fldPath.Child("webhook", "url"), webhook.URL, "http", "https"); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if ref := webhook.AuthorizationSecretRef; ref != nil {
+			allErrs = append(allErrs, validateKeyRef(fldPath.Child("webhook", "authorizationSecretRef"),
+				ref.Name, ref.Key)...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateMetricsConfig validates that router metrics are served on a valid port. Collisions with other
+// listeners are reported by validateListenerPorts.
+func validateMetricsConfig(fldPath *field.Path, metrics *gatewayv1alpha1.MetricsConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, msg := range validation.IsValidPortNum(int(metrics.Port)) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), metrics.Port, msg))
+	}
+
+	return allErrs
+}
+
+// validateListenerPorts ensures the listeners of the gateway pods use distinct ports that are not reserved.
+func validateListenerPorts(specPath *field.Path, spec *gatewayv1alpha1.AiGatewaySpec) field.ErrorList {
+	type listener struct {
+		name string
+		path *field.Path
+		port int32
+	}
+	listeners := []listener{{name: "gateway", path: specPath.Child("port"), port: spec.Port}}
+	if spec.Observability != nil && spec.Observability.Metrics != nil {
+		listeners = append(listeners, listener{name: "metrics",
+			path: specPath.Child("observability", "metrics", "port"), port: spec.Observability.Metrics.Port})
+	}
+
+	var allErrs field.ErrorList
+	for i, l := range listeners {
+		if l.port <= 0 {
+			continue
+		}
+		if user, reserved := gatewayv1alpha1.ReservedPorts[l.port]; reserved {
+			allErrs = append(allErrs, field.Invalid(l.path, l.port, fmt.Sprintf("port is reserved for the %s", user)))
+		}
+		for _, other := range listeners[:i] {
+			if other.port == l.port {
+				allErrs = append(allErrs, field.Invalid(l.path, l.port,
+					fmt.Sprintf("%s port must differ from the %s port", l.name, other.name)))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateRoutingConfig ensures strategy-specific options are only set for their strategy.
+func validateRoutingConfig(fldPath *field.Path, routing *gatewayv1alpha1.RoutingConfig,
+	names []string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if latencyBased := routing.LatencyBased; latencyBased != nil {
+		if routing.Strategy != gatewayv1alpha1.RoutingStrategyLatencyBased {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("latencyBased"),
+				fmt.Sprintf("only allowed for strategy %q", gatewayv1alpha1.RoutingStrategyLatencyBased)))
+		}
+		if latencyBased.TTLSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("latencyBased", "ttlSeconds"),
+				latencyBased.TTLSeconds, "must not be negative"))
+		}
+		if latencyBased.BufferPercent < 0 || latencyBased.BufferPercent > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("latencyBased", "bufferPercent"),
+				latencyBased.BufferPercent, "must be between 0 and 100"))
+		}
+	}
+
+	for i, rule := range routing.Rules {
+		allErrs = append(allErrs, validateRoutingRule(fldPath.Child("rules").Index(i), rule, names)...)
+	}
+
+	return allErrs
+}
+
+// validateRoutingRule validates the header matches of a routing rule and ensures it routes to a model
+// of the gateway. Model references are not checked if the models are imported.
+func validateRoutingRule(fldPath *field.Path, rule gatewayv1alpha1.RoutingRule,
+	names []string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(rule.Headers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("headers"), "at least one header match is required"))
+	}
+	for i, header := range rule.Headers {
+		headerPath := fldPath.Child("headers").Index(i)
+		for _, msg := range validation.IsHTTPHeaderName(header.Name) {
+			allErrs = append(allErrs, field.Invalid(headerPath.Child("name"), header.Name, msg))
+		}
+		if header.Type == gatewayv1alpha1.HeaderMatchRegularExpression {
+			if _, err := regexp.Compile(header.Value); err != nil {
+				allErrs = append(allErrs, field.Invalid(headerPath.Child("value"), header.Value,
+					fmt.Sprintf("invalid regular expression: %v", err)))
+			}
+		}
+	}
+
+	if rule.Model == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("model"), "model cannot be empty"))
+	} else if !hasModel(names, rule.Model) {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("model"), rule.Model))
+	}
+
+	return allErrs
+}
+
+// knownNames returns the public names of the non-wildcard models and the model groups of the gateway
+// if they are fully known at admission, and nil if the gateway imports models from model libraries or a baseline.
+func knownNames(spec *gatewayv1alpha1.AiGatewaySpec) []string {
+	if len(spec.ModelLibraries) > 0 || spec.Extends != nil {
+		return nil
+	}
+	var names []string
+	for _, model := range spec.AiModels {
+		if !model.IsWildcard() {
+			names = append(names, model.PublicName())
+		}
+	}
+	for _, group := range spec.ModelGroups {
+		names = append(names, group.Name)
+	}
+	return names
+}
+
+// hasModel reports whether a model or model group of the gateway is exposed under name. If the names of the
+// gateway are unknown, e.g. because its models are imported, every name is assumed to exist.
+func hasModel(names []string, name string) bool {
+	return len(names) == 0 || slices.Contains(names, name)
+}
+
+// validateMirrorConfig ensures a mirror targets another model of the gateway. The target is not checked
+// if the models of the gateway are unknown, e.g. because they are imported.
+func validateMirrorConfig(fldPath *field.Path, mirror *gatewayv1alpha1.MirrorConfig, source string,
+	names []string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case mirror.Model == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("model"), "model cannot be empty"))
+	case mirror.Model == source:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("model"), mirror.Model,
+			"a model cannot be mirrored to itself"))
+	case !hasModel(names, mirror.Model):
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("model"), mirror.Model))
+	}
+	if percent := mirror.Percent; percent != nil && (*percent < 0 || *percent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("percent"), *percent, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+// validateCanaryRollout ensures the canary steps shift an increasing share of traffic to the canary.
+func validateCanaryRollout(fldPath *field.Path, canary *gatewayv1alpha1.CanaryRollout) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(canary.Steps) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("steps"), "at least one step is required"))
+	}
+	var previousWeight int32
+	for i, step := range canary.Steps {
+		stepPath := fldPath.Child("steps").Index(i)
+		switch {
+		case step.Weight < 1 || step.Weight > 100:
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("weight"), step.Weight, "must be between 1 and 100"))
+		case step.Weight <= previousWeight:
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("weight"), step.Weight,
+				fmt.Sprintf("must be greater than the weight of the previous step (%d)", previousWeight)))
+		}
+		previousWeight = step.Weight
+		if step.Pause != nil && step.Pause.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("pause"), step.Pause.Duration.String(),
+				"must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateJWTAuth validates the issuer and ensures at most one source of signing keys is configured.
+func validateJWTAuth(fldPath *field.Path, jwt *gatewayv1alpha1.JWTAuth) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if jwt.Issuer == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuer"), "issuer cannot be empty"))
+	} else if err := validateURL(fldPath.Child("issuer"), jwt.Issuer, "https"); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if jwt.JWKSURI != "" {
+		if err := validateURL(fldPath.Child("jwksURI"), jwt.JWKSURI, "https"); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if jwt.JWKSSecretRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("jwksSecretRef"),
+				"jwksSecretRef cannot be combined with jwksURI"))
+		}
+	}
+	if jwt.JWKSSecretRef != nil {
+		allErrs = append(allErrs, validateKeyRef(fldPath.Child("jwksSecretRef"),
+			jwt.JWKSSecretRef.Name, jwt.JWKSSecretRef.Key)...)
+	}
+
+	return allErrs
+}
+
+// validateEgressConfig validates the proxy URLs and the CA bundle reference.
+func validateEgressConfig(fldPath *field.Path, egress *gatewayv1alpha1.EgressConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if egress.HTTPProxy != "" {
+		if err := validateURL(fldPath.Child("httpProxy"), egress.HTTPProxy, "http", "https"); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if egress.HTTPSProxy != "" {
+		if err := validateURL(fldPath.Child("httpsProxy"), egress.HTTPSProxy, "http", "https"); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	for i, entry := range egress.NoProxy {
+		if entry == "" || strings.ContainsFunc(entry, unicode.IsSpace) || strings.Contains(entry, ",") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("noProxy").Index(i), entry,
+				"must be a single non-empty host, domain or CIDR"))
+		}
+	}
+	if egress.CABundleRef != nil {
+		allErrs = append(allErrs, validateContentSource(fldPath.Child("caBundleRef"), *egress.CABundleRef)...)
+	}
+
+	return allErrs
+}
+
+// validatePodClassNames ensures the PriorityClass and RuntimeClass names are valid object names.
+func validatePodClassNames(specPath *field.Path, spec gatewayv1alpha1.AiGatewaySpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if spec.PriorityClassName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(spec.PriorityClassName) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("priorityClassName"), spec.PriorityClassName, msg))
+		}
+	}
+	if spec.RuntimeClassName != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*spec.RuntimeClassName) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("runtimeClassName"), *spec.RuntimeClassName, msg))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSchedules validates the scaling schedules: unique names, valid cron expressions and time zones and
+// positive durations. Schedules set the replicas and therefore cannot be combined with autoscaling.
+func validateSchedules(fldPath *field.Path, spec *gatewayv1alpha1.AiGatewaySpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(spec.Schedules) > 0 && spec.Autoscaling != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "schedules cannot be combined with spec.autoscaling"))
+	}
+
+	names := make(map[string]bool)
+	for i, schedule := range spec.Schedules {
+		schedulePath := fldPath.Index(i)
+		if names[schedule.Name] {
+			allErrs = append(allErrs, field.Duplicate(schedulePath.Child("name"), schedule.Name))
+		}
+		names[schedule.Name] = true
+
+		if err := gatewayv1alpha1.ParseCron(schedule.Start); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("start"), schedule.Start, err.Error()))
+		}
+		if schedule.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(schedulePath.Child("duration"), schedule.Duration.String(),
+				"must be positive"))
+		}
+		if schedule.TimeZone != "" {
+			if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulePath.Child("timeZone"), schedule.TimeZone,
+					"must be an IANA time zone, e.g. Europe/Berlin"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateAutoscalingConfig ensures the replica limits are consistent.
+func validateAutoscalingConfig(fldPath *field.Path, autoscaling *gatewayv1alpha1.AutoscalingConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if autoscaling.MaxReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), autoscaling.MaxReplicas,
+			"must be at least 1"))
+	}
+	if minReplicas := autoscaling.MinReplicas; minReplicas != nil {
+		if *minReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *minReplicas, "must be at least 1"))
+		} else if *minReplicas > autoscaling.MaxReplicas {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *minReplicas,
+				"must not be greater than maxReplicas"))
+		}
+	}
+	if autoscaling.KEDA != nil {
+		allErrs = append(allErrs, validateKEDAConfig(fldPath, autoscaling)...)
+	}
+
+	return allErrs
+}
+
+// validateKEDAConfig ensures KEDA scaling is not combined with HorizontalPodAutoscaler targets
+// and that all triggers are complete.
+func validateKEDAConfig(fldPath *field.Path, autoscaling *gatewayv1alpha1.AutoscalingConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	kedaPath := fldPath.Child("keda")
+	keda := autoscaling.KEDA
+
+	if autoscaling.TargetCPUUtilizationPercentage != nil || autoscaling.TargetMemoryUtilizationPercentage != nil ||
+		len(autoscaling.Metrics) > 0 {
+		allErrs = append(allErrs, field.Forbidden(kedaPath,
+			"keda cannot be combined with utilization targets or metrics"))
+	}
+	if err := validateURL(kedaPath.Child("serverAddress"), keda.ServerAddress, "http", "https"); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if len(keda.Triggers) == 0 {
+		allErrs = append(allErrs, field.Required(kedaPath.Child("triggers"), "at least one trigger is required"))
+	}
+	names := make(map[string]bool, len(keda.Triggers))
+	for i, trigger := range keda.Triggers {
+		triggerPath := kedaPath.Child("triggers").Index(i)
+		if names[trigger.Name] {
+			allErrs = append(allErrs, field.Duplicate(triggerPath.Child("name"), trigger.Name))
+		}
+		names[trigger.Name] = true
+		if trigger.Query == "" {
+			allErrs = append(allErrs, field.Required(triggerPath.Child("query"), "query cannot be empty"))
+		}
+		if !decimalPattern.MatchString(trigger.Threshold) {
+			allErrs = append(allErrs, field.Invalid(triggerPath.Child("threshold"), trigger.Threshold,
+				"must be a non-negative decimal number"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateScaleToZeroConfig ensures the idle period and activation timeout are positive.
+func validateScaleToZeroConfig(fldPath *field.Path, scaleToZero *gatewayv1alpha1.ScaleToZeroConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if scaleToZero.IdleAfter.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleAfter"), scaleToZero.IdleAfter.Duration.String(),
+			"must be positive"))
+	}
+	if timeout := scaleToZero.ActivationTimeout; timeout != nil && timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("activationTimeout"), timeout.Duration.String(),
+			"must be positive"))
+	}
+
+	return allErrs
+}
+
+// validateProbesConfig validates the probe paths and ensures only the readiness probe
+// sets a success threshold other than 1, as Kubernetes requires.
+func validateProbesConfig(fldPath *field.Path, probes *gatewayv1alpha1.ProbesConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, probe := range []struct {
+		name     string
+		settings *gatewayv1alpha1.ProbeSettings
+	}{
+		{"liveness", probes.Liveness},
+		{"readiness", probes.Readiness},
+		{"startup", probes.Startup},
+	} {
+		if probe.settings == nil {
+			continue
+		}
+		probePath := fldPath.Child(probe.name)
+		if path := probe.settings.Path; path != "" && !strings.HasPrefix(path, "/") {
+			allErrs = append(allErrs, field.Invalid(probePath.Child("path"), path, "must start with '/'"))
+		}
+		if threshold := probe.settings.SuccessThreshold; threshold != nil && *threshold != 1 &&
+			probe.name != "readiness" {
+			allErrs = append(allErrs, field.Invalid(probePath.Child("successThreshold"), *threshold,
+				"must be 1 for liveness and startup probes"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateUpdateStrategy ensures rolling update parameters are only set for rolling updates
+// and allow the rollout to make progress.
+func validateUpdateStrategy(fldPath *field.Path, strategy *appsv1.DeploymentStrategy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	rollingUpdate := strategy.RollingUpdate
+	if rollingUpdate == nil {
+		return nil
+	}
+	if strategy.Type == appsv1.RecreateDeploymentStrategyType {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rollingUpdate"),
+			fmt.Sprintf("may not be specified when strategy type is %q", appsv1.RecreateDeploymentStrategyType)))
+	}
+	if isZero(rollingUpdate.MaxSurge) && isZero(rollingUpdate.MaxUnavailable) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rollingUpdate", "maxUnavailable"),
+			rollingUpdate.MaxUnavailable.String(), "may not be 0 when maxSurge is 0"))
+	}
+
+	return allErrs
+}
+
+// isZero reports whether value is explicitly set to 0 or "0%".
+func isZero(value *intstr.IntOrString) bool {
+	return value != nil && (value.String() == "0" || value.String() == "0%")
+}
+
+// validateLoggingConfig validates the redaction patterns and warns if detailed request logs
+// may contain unredacted user content.
+func validateLoggingConfig(fldPath *field.Path, logging *gatewayv1alpha1.LoggingConfig) (admission.Warnings,
+	field.ErrorList) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+
+	redaction := logging.Redaction
+	if logging.DetailedRequests && (redaction == nil || !redaction.Prompts || !redaction.Responses) {
+		warnings = append(warnings, "spec.logging.detailedRequests logs prompts and completions, "+
+			"which may contain sensitive data unless they are redacted")
+	}
+	if redaction != nil {
+		for i, pattern := range redaction.Patterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("redaction", "patterns").Index(i), pattern,
+					fmt.Sprintf("must be a valid regular expression: %v", err)))
+			}
+		}
+	}
+
+	return warnings, allErrs
+}
+
+// validateAuditConfig validates the audit bucket and ensures S3-only settings are not used for other providers.
+func validateAuditConfig(fldPath *field.Path, audit *gatewayv1alpha1.AuditConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if audit.Bucket == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bucket"), "bucket cannot be empty"))
+	}
+	if audit.Provider != gatewayv1alpha1.AuditStorageS3 {
+		if audit.Region != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("region"),
+				fmt.Sprintf("only allowed for provider %q", gatewayv1alpha1.AuditStorageS3)))
+		}
+		if audit.Endpoint != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("endpoint"),
+				fmt.Sprintf("only allowed for provider %q", gatewayv1alpha1.AuditStorageS3)))
+		}
+	}
+	if audit.Endpoint != "" {
+		if err := validateURL(fldPath.Child("endpoint"), audit.Endpoint, "http", "https"); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	if ref := audit.CredentialsSecretRef; ref != nil && ref.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("credentialsSecretRef", "name"), "name cannot be empty"))
+	}
+	if rate := audit.SamplingPercent; rate != nil && (*rate < 0 || *rate > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("samplingPercent"), *rate, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
+// validateTrafficSplit ensures a traffic split has a unique alias and splits all requests between distinct models
+// of the gateway. Backend models are not checked if the models of the gateway are unknown, e.g. because they are imported.
+func validateTrafficSplit(fldPath *field.Path, index int, split gatewayv1alpha1.TrafficSplit,
+	splits []gatewayv1alpha1.TrafficSplit, names []string) field.ErrorList {
+	var allErrs field.ErrorList
+	splitPath := fldPath.Index(index)
+
+	if split.Alias == "" {
+		allErrs = append(allErrs, field.Required(splitPath.Child("alias"), "alias cannot be empty"))
+	} else {
+		if j := slices.IndexFunc(splits, func(other gatewayv1alpha1.TrafficSplit) bool {
+			return other.Alias == split.Alias
+		}); j < index {
+			allErrs = append(allErrs, field.Duplicate(splitPath.Child("alias"),
+				fmt.Sprintf("%s (already used by %s)", split.Alias, fldPath.Index(j))))
+		}
+		if slices.Contains(names, split.Alias) {
+			allErrs = append(allErrs, field.Invalid(splitPath.Child("alias"), split.Alias,
+				"alias collides with the public name of a model or model group"))
+		}
+	}
+
+	if len(split.Backends) < 2 {
+		allErrs = append(allErrs, field.Invalid(splitPath.Child("backends"), len(split.Backends),
+			"at least two backends are required"))
+	}
+	seen := make(map[string]bool)
+	var total int32
+	for i, backend := range split.Backends {
+		backendPath := splitPath.Child("backends").Index(i)
+		switch {
+		case backend.Model == "":
+			allErrs = append(allErrs, field.Required(backendPath.Child("model"), "model cannot be empty"))
+		case seen[backend.Model]:
+			allErrs = append(allErrs, field.Duplicate(backendPath.Child("model"), backend.Model))
+		case !hasModel(names, backend.Model):
+			allErrs = append(allErrs, field.NotFound(backendPath.Child("model"), backend.Model))
+		}
+		seen[backend.Model] = true
+		if backend.Percent < 0 || backend.Percent > 100 {
+			allErrs = append(allErrs, field.Invalid(backendPath.Child("percent"), backend.Percent,
+				"must be between 0 and 100"))
+		}
+		total += backend.Percent
+	}
+	if len(split.Backends) > 0 && total != 100 {
+		allErrs = append(allErrs, field.Invalid(splitPath.Child("backends"), total,
+			"percentages must add up to 100"))
+	}
+
+	return allErrs
+}
+
+// validateHookEndpoint validates the URL, credentials and timeout of a hook, if set.
+func validateHookEndpoint(fldPath *field.Path, hook *gatewayv1alpha1.HookEndpoint) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if hook == nil {
+		return allErrs
+	}
+	if err := validateURL(fldPath.Child("url"), hook.URL, "http", "https"); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if ref := hook.AuthorizationSecretRef; ref != nil {
+		allErrs = append(allErrs, validateKeyRef(fldPath.Child("authorizationSecretRef"), ref.Name, ref.Key)...)
+	}
+	if timeout := hook.Timeout; timeout != nil && timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), timeout.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
+
+// validateMCPServers ensures MCP server names are unique and each server has exactly one endpoint.
+func validateMCPServers(fldPath *field.Path, servers []gatewayv1alpha1.MCPServer) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[string]int)
+	for i, server := range servers {
+		serverPath := fldPath.Index(i)
+		if j, exists := names[server.Name]; exists {
+			allErrs = append(allErrs, field.Duplicate(serverPath.Child("name"),
+				fmt.Sprintf("%s (already used by %s)", server.Name, fldPath.Index(j))))
+		} else {
+			names[server.Name] = i
+		}
+		for _, msg := range validation.IsDNS1123Label(server.Name) {
+			allErrs = append(allErrs, field.Invalid(serverPath.Child("name"), server.Name, msg))
+		}
+
+		switch {
+		case server.URL == "" && server.BackendRef == nil:
+			allErrs = append(allErrs, field.Required(serverPath, "one of url or backendRef must be set"))
+		case server.URL != "" && server.BackendRef != nil:
+			allErrs = append(allErrs, field.Forbidden(serverPath.Child("url"), "url cannot be combined with backendRef"))
+		case server.URL != "":
+			if err := validateURL(serverPath.Child("url"), server.URL, "http", "https"); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		default:
+			allErrs = append(allErrs, validateBackendServiceRef(serverPath.Child("backendRef"), server.BackendRef)...)
+		}
+
+		if ref := server.AuthorizationSecretRef; ref != nil {
+			allErrs = append(allErrs, validateKeyRef(serverPath.Child("authorizationSecretRef"), ref.Name, ref.Key)...)
+		}
+		for j, tool := range server.AllowedTools {
+			if tool == "" {
+				allErrs = append(allErrs, field.Required(serverPath.Child("allowedTools").Index(j),
+					"tool name cannot be empty"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateA2AProtocol ensures the A2A endpoint does not shadow the OpenAI-compatible or MCP endpoints
+// and is answered by a model of the gateway. The model is not checked if the models are imported.
+func validateA2AProtocol(fldPath *field.Path, a2a *gatewayv1alpha1.A2AProtocol,
+	names []string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if a2a.Path != "" {
+		switch {
+		case !strings.HasPrefix(a2a.Path, "/"):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), a2a.Path, "must start with '/'"))
+		case slices.ContainsFunc([]string{"/v1", "/mcp", "/.well-known"}, func(reserved string) bool {
+			return a2a.Path == reserved || strings.HasPrefix(a2a.Path, reserved+"/")
+		}):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), a2a.Path,
+				"must not be below /v1, /mcp or /.well-known, which are served by the gateway"))
+		}
+	}
+
+	switch {
+	case a2a.Model == "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("model"), "model cannot be empty"))
+	case !hasModel(names, a2a.Model):
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("model"), a2a.Model))
+	}
+
+	return allErrs
+}
+
+// validateStreamingConfig ensures the stream durations are positive and consistent with the streaming mode.
+func validateStreamingConfig(fldPath *field.Path, streaming *gatewayv1alpha1.StreamingConfig) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, duration := range []struct {
+		name  string
+		value *metav1.Duration
+	}{
+		{"timeout", streaming.Timeout},
+		{"keepaliveInterval", streaming.KeepaliveInterval},
+	} {
+		if duration.value == nil {
+			continue
+		}
+		if streaming.Mode == gatewayv1alpha1.StreamingModeDisable {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(duration.name),
+				fmt.Sprintf("not allowed for mode %q", streaming.Mode)))
+		} else if duration.value.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(duration.name), duration.value.Duration.String(),
+				"must be positive"))
+		}
+	}
+	if streaming.Timeout != nil && streaming.KeepaliveInterval != nil &&
+		streaming.KeepaliveInterval.Duration >= streaming.Timeout.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("keepaliveInterval"),
+			streaming.KeepaliveInterval.Duration.String(), "must be shorter than the stream timeout"))
+	}
+
+	return allErrs
+}
+
+// validateCircuitBreaker ensures the circuit breaker cooldown is positive.
+func validateCircuitBreaker(fldPath *field.Path, breaker *gatewayv1alpha1.CircuitBreakerConfig) field.ErrorList {
+	var allErrs field.ErrorList
+	if breaker.Cooldown != nil && breaker.Cooldown.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cooldown"), breaker.Cooldown.Duration.String(),
+			"must be positive"))
+	}
+	return allErrs
+}
+
+// validateRequestTokenLimits ensures the per-request token caps of a gateway, model or AiRateLimitPolicy are
+// positive.
+func validateRequestTokenLimits(fldPath *field.Path, limits *gatewayv1alpha1.RequestTokenLimits) field.ErrorList {
+	var allErrs field.ErrorList
+	if limit := limits.MaxInputTokens; limit != nil && *limit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxInputTokens"), *limit, "must be at least 1"))
+	}
+	if limit := limits.MaxOutputTokens; limit != nil && *limit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxOutputTokens"), *limit, "must be at least 1"))
+	}
+	return allErrs
+}
+
+// validatePromptPolicy validates a gateway-, model- or route-level prompt policy.
+func validatePromptPolicy(fldPath *field.Path, policy *gatewayv1alpha1.PromptPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if prefix := policy.SystemPrefix; prefix != nil {
+		prefixPath := fldPath.Child("systemPrefix")
+		switch {
+		case prefix.TemplateRef == nil:
+			allErrs = append(allErrs, validateContentSource(prefixPath, prefix.ContentSource)...)
+		case prefix.ConfigMapKeyRef != nil || prefix.SecretKeyRef != nil:
+			allErrs = append(allErrs, field.Forbidden(prefixPath.Child("templateRef"),
+				"cannot be combined with configMapKeyRef or secretKeyRef"))
+		case prefix.TemplateRef.Name == "":
+			allErrs = append(allErrs, field.Required(prefixPath.Child("templateRef", "name"), "name cannot be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateContentSource checks that exactly one of configMapKeyRef or secretKeyRef is set and fully specified.
+func validateContentSource(fldPath *field.Path, source gatewayv1alpha1.ContentSource) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case source.ConfigMapKeyRef == nil && source.SecretKeyRef == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "one of configMapKeyRef or secretKeyRef must be set"))
+	case source.ConfigMapKeyRef != nil && source.SecretKeyRef != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only one of configMapKeyRef or secretKeyRef may be set"))
+	case source.ConfigMapKeyRef != nil:
+		allErrs = append(allErrs, validateKeyRef(fldPath.Child("configMapKeyRef"),
+			source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)...)
+	default:
+		allErrs = append(allErrs, validateKeyRef(fldPath.Child("secretKeyRef"),
+			source.SecretKeyRef.Name, source.SecretKeyRef.Key)...)
+	}
+
+	return allErrs
+}
+
+// validateKeyRef checks that both name and key of a ConfigMap or Secret key reference are set.
+func validateKeyRef(fldPath *field.Path, name, key string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name cannot be empty"))
+	}
+	if key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "key cannot be empty"))
+	}
+
+	return allErrs
+}
+
+// validateURL checks that rawURL is an absolute URL with a host and one of the allowed schemes.
+func validateURL(fldPath *field.Path, rawURL string, allowedSchemes ...string) *field.Error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return field.Invalid(fldPath, rawURL, fmt.Sprintf("must be a valid URL: %v", err))
+	}
+	if u.Host == "" {
+		return field.Invalid(fldPath, rawURL, "must be an absolute URL including a host")
+	}
+	if !slices.Contains(allowedSchemes, u.Scheme) {
+		return field.Invalid(fldPath, rawURL, fmt.Sprintf("unsupported URL scheme %q, must be one of: %s",
+			u.Scheme, strings.Join(allowedSchemes, ", ")))
+	}
+	return nil
 }
@@ -17,10 +17,25 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/providers"
 )
 
 var _ = Describe("AiGateway Webhook", func() {
@@ -34,9 +49,9 @@ var _ = Describe("AiGateway Webhook", func() {
 	BeforeEach(func() {
 		obj = &gatewayv1alpha1.AiGateway{}
 		oldObj = &gatewayv1alpha1.AiGateway{}
-		validator = AiGatewayCustomValidator{}
+		validator = AiGatewayCustomValidator{Client: k8sClient, ClassNamespace: "default"}
 		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
-		defaulter = AiGatewayCustomDefaulter{}
+		defaulter = AiGatewayCustomDefaulter{Client: k8sClient, ClassNamespace: "default"}
 		Expect(defaulter).NotTo(BeNil(), "Expected defaulter to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
@@ -65,6 +80,62 @@ var _ = Describe("AiGateway Webhook", func() {
 			By("checking that the custom port is preserved")
 			Expect(obj.Spec.Port).To(Equal(int32(8080)))
 		})
+
+		It("Should apply the default metrics port when metrics are configured without a port", func() {
+			By("configuring metrics without a port")
+			obj.Spec.Observability = &gatewayv1alpha1.ObservabilityConfig{
+				Metrics: &gatewayv1alpha1.MetricsConfig{DedicatedService: true},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.Observability.Metrics.Port).To(Equal(int32(9090)))
+		})
+
+		It("Should normalize model names", func() {
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: " OpenAI/gpt-4", Provider: "OpenAI "},
+				{Name: "claude-3-opus", Provider: "anthropic", Alias: "claude"},
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "OpenAI/*", Provider: "openai"},
+				{Name: "meta-llama/Llama-3-8B", Provider: "huggingface"},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AiModels).To(Equal([]gatewayv1alpha1.AiModel{
+				{Name: "openai/gpt-4", Provider: "openai"},
+				{Name: "anthropic/claude-3-opus", Provider: "anthropic", Alias: "claude"},
+				{Name: "openai/gpt-4o", Provider: "openai", Alias: "gpt-4o"},
+				{Name: "openai/*", Provider: "openai"},
+				{Name: "huggingface/meta-llama/Llama-3-8B", Provider: "huggingface", Alias: "meta-llama/Llama-3-8B"},
+			}))
+		})
+
+		It("Should assign the default AiGatewayClass when no class is specified", func() {
+			By("calling the Default method without a default class")
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AiGatewayClassName).To(BeEmpty())
+
+			By("creating a default AiGatewayClass")
+			defaultClass := &gatewayv1alpha1.AiGatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "default-class",
+					Namespace:   "default",
+					Annotations: map[string]string{DefaultClassAnnotation: "true"},
+				},
+				Spec: gatewayv1alpha1.AiGatewayClassSpec{Controller: "test-controller"},
+			}
+			Expect(k8sClient.Create(ctx, defaultClass)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, defaultClass)).To(Succeed())
+			})
+
+			By("calling the Default method")
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AiGatewayClassName).To(Equal("default-class"))
+
+			By("keeping an explicitly specified class")
+			obj.Spec.AiGatewayClassName = "custom-class"
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.Spec.AiGatewayClassName).To(Equal("custom-class"))
+		})
 	})
 
 	Context("When creating or updating AiGateway under Validating Webhook", func() {
@@ -94,6 +165,25 @@ var _ = Describe("AiGateway Webhook", func() {
 			Expect(err.Error()).To(ContainSubstring("no AI models specified"))
 		})
 
+		It("Should admit creation without AI models if an existing config is imported", func() {
+			By("creating an AiGateway referencing an existing LiteLLM config")
+			obj.Spec.Port = 4000
+			obj.Spec.ImportConfigRef = &gatewayv1alpha1.ContentSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "litellm-config"},
+					Key:                  "config.yaml",
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("creating an AiGateway with an incomplete import reference")
+			obj.Spec.ImportConfigRef.ConfigMapKeyRef.Key = ""
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.importConfigRef.configMapKeyRef.key"))
+		})
+
 		It("Should deny creation if AI model name is empty", func() {
 			By("creating an AiGateway with empty model name")
 			obj.Spec.Port = 4000
@@ -116,15 +206,2024 @@ var _ = Describe("AiGateway Webhook", func() {
 			Expect(err.Error()).To(ContainSubstring("AI model provider cannot be empty"))
 		})
 
-		It("Should admit creation if all required fields are valid", func() {
-			By("creating a valid AiGateway")
+		It("Should deny creation if the metrics port collides with the gateway port", func() {
+			By("creating an AiGateway serving metrics on the gateway port")
 			obj.Spec.Port = 4000
 			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
 				{Name: "gpt-4", Provider: "openai"},
-				{Name: "claude-3-opus", Provider: "anthropic"},
+			}
+			obj.Spec.Observability = &gatewayv1alpha1.ObservabilityConfig{
+				Metrics: &gatewayv1alpha1.MetricsConfig{Port: 4000},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("metrics port must differ from the gateway port"))
+
+			By("serving metrics on a separate port")
+			obj.Spec.Observability.Metrics.Port = 9090
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny listeners on reserved ports", func() {
+			By("creating an AiGateway serving metrics on the port of the Istio proxy telemetry")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Observability = &gatewayv1alpha1.ObservabilityConfig{
+				Metrics: &gatewayv1alpha1.MetricsConfig{Port: 15090},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				"spec.observability.metrics.port: Invalid value: 15090: port is reserved for the Istio proxy telemetry"))
+
+			By("exposing the gateway on the port of the Istio health checks")
+			obj.Spec.Port = 15021
+			obj.Spec.Observability.Metrics.Port = 9090
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.port: Invalid value: 15021"))
+		})
+
+		It("Should validate consumers opting out of response caching", func() {
+			By("creating an AiGateway with a caching opt-out for a team")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Caching = &gatewayv1alpha1.CachingConfig{
+				Enabled: true,
+				OptOut:  &gatewayv1alpha1.ConsumerSelector{Teams: []string{"health-records"}},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			By("disabling caching while keeping the opt-out")
+			obj.Spec.Caching.Enabled = false
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("has no effect")))
+
+			By("creating an AiGateway with an empty caching opt-out")
+			obj.Spec.Caching.OptOut = &gatewayv1alpha1.ConsumerSelector{}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.caching.optOut"))
+		})
+
+		It("Should validate the routing strategy options", func() {
+			By("creating an AiGateway with latency-based routing")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Routing = &gatewayv1alpha1.RoutingConfig{
+				Strategy:     gatewayv1alpha1.RoutingStrategyLatencyBased,
+				LatencyBased: &gatewayv1alpha1.LatencyBasedRoutingOptions{TTLSeconds: 60, BufferPercent: 10},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("using latency-based options with a different strategy")
+			obj.Spec.Routing.Strategy = gatewayv1alpha1.RoutingStrategyLeastBusy
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`only allowed for strategy "latency-based"`))
+
+			By("using an out of range buffer")
+			obj.Spec.Routing.Strategy = gatewayv1alpha1.RoutingStrategyLatencyBased
+			obj.Spec.Routing.LatencyBased.BufferPercent = 150
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be between 0 and 100"))
+		})
+
+		It("Should require a complete database secret reference", func() {
+			By("creating an AiGateway with a database secret")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Database = &gatewayv1alpha1.DatabaseConfig{
+				SecretRef: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "litellm-db"},
+					Key:                  "dsn",
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("omitting the secret key")
+			obj.Spec.Database.SecretRef.Key = ""
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.database.secretRef.key"))
+		})
+
+		It("Should warn about multiple replicas without Redis", func() {
+			By("creating an AiGateway with multiple replicas")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Replicas = ptr.To(int32(3))
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.redisRef is not set")))
+
+			By("referencing a Redis secret")
+			obj.Spec.RedisRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "redis"},
+				Key:                  "url",
+			}
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			By("omitting the secret name")
+			obj.Spec.RedisRef.Name = ""
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.redisRef.name"))
+		})
+
+		It("Should validate canary rollout steps", func() {
+			By("creating an AiGateway with increasing canary weights")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Rollout = &gatewayv1alpha1.RolloutConfig{
+				Canary: &gatewayv1alpha1.CanaryRollout{
+					Steps: []gatewayv1alpha1.CanaryStep{
+						{Weight: 10, Pause: &metav1.Duration{Duration: 5 * time.Minute}},
+						{Weight: 50},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("decreasing the weight")
+			obj.Spec.Rollout.Canary.Steps[1].Weight = 5
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be greater than the weight of the previous step (10)"))
+
+			By("omitting the steps")
+			obj.Spec.Rollout.Canary.Steps = nil
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("at least one step is required"))
+		})
+
+		It("Should count denials per kind, namespace, name and reason", func() {
+			obj.Namespace = "metrics-test"
+			obj.Name = "gateway"
+			obj.Spec.Port = 4000
+			denials := webhookDenials.WithLabelValues("AiGateway", "metrics-test", "gateway", "Forbidden")
+			count := testutil.ToFloat64(denials)
+
+			By("denying an AiGateway without models")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(testutil.ToFloat64(denials)).To(Equal(count + 1))
+
+			By("not counting the denial of a dry-run request")
+			dryRun := admission.NewContextWithRequest(ctx, admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{DryRun: ptr.To(true)},
+			})
+			_, err = validator.ValidateCreate(dryRun, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(testutil.ToFloat64(denials)).To(Equal(count + 1))
+
+			By("labeling an AiGateway without a name by its generateName")
+			generated := obj.DeepCopy()
+			generated.Name = ""
+			generated.GenerateName = "gateway-"
+			generatedDenials := webhookDenials.WithLabelValues("AiGateway", "metrics-test", "gateway-", "Forbidden")
+			generatedCount := testutil.ToFloat64(generatedDenials)
+			_, err = validator.ValidateCreate(ctx, generated)
+			Expect(err).To(HaveOccurred())
+			Expect(testutil.ToFloat64(generatedDenials)).To(Equal(generatedCount + 1))
+
+			By("not counting an admitted AiGateway")
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{Name: "gpt-4", Provider: "openai"}}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testutil.ToFloat64(denials)).To(Equal(count + 1))
+		})
+
+		It("Should deny a usage collection interval longer than the window", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Observability = &gatewayv1alpha1.ObservabilityConfig{
+				Usage: &gatewayv1alpha1.UsageReportingConfig{
+					Window:   &metav1.Duration{Duration: time.Hour},
+					Interval: &metav1.Duration{Duration: 5 * time.Minute},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Observability.Usage.Interval.Duration = 2 * time.Hour
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.observability.usage.interval"))
+			Expect(err.Error()).To(ContainSubstring("must not exceed the window"))
+		})
+
+		It("Should deny models not allowed by an AiModelPolicy in the namespace", func() {
+			By("creating an AiModelPolicy allowing only Azure models")
+			policy := &gatewayv1alpha1.AiModelPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "azure-only", Namespace: "default"},
+				Spec:       gatewayv1alpha1.AiModelPolicySpec{AllowedModels: []string{"azure/*"}},
+			}
+			Expect(k8sClient.Create(ctx, policy)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, policy)).To(Succeed())
+			})
+
+			By("creating an AiGateway with an OpenAI model")
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`model "openai/gpt-4" is not allowed by AiModelPolicy "azure-only"`))
+
+			By("creating the AiGateway in another namespace")
+			obj.Namespace = "other"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny AiGateways exceeding an AiGatewayQuota in the namespace", func() {
+			By("creating an AiGatewayQuota and an existing AiGateway")
+			quota := &gatewayv1alpha1.AiGatewayQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-quota", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewayQuotaSpec{
+					MaxGateways: ptr.To(int32(2)),
+					MaxModels:   ptr.To(int32(3)),
+				},
+			}
+			Expect(k8sClient.Create(ctx, quota)).To(Succeed())
+			existing := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port: 4000,
+					AiModels: []gatewayv1alpha1.AiModel{
+						{Name: "gpt-4", Provider: "openai"},
+						{Name: "claude-3-opus", Provider: "anthropic"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, existing)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, existing)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, quota)).To(Succeed())
+			})
+
+			By("creating a second AiGateway within the quota")
+			obj.Name = "second"
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("exceeding the model limit")
+			obj.Spec.AiModels = append(obj.Spec.AiModels, gatewayv1alpha1.AiModel{Name: "gpt-4o-mini", Provider: "openai"})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`exceeds AiGatewayQuota "team-quota": 4 models in namespace, limit is 3`))
+
+			By("updating the existing AiGateway without adding models")
+			_, err = validator.ValidateUpdate(ctx, existing, existing)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("counting the models inherited from a baseline")
+			obj.Spec.AiModels = nil
+			obj.Spec.Extends = &gatewayv1alpha1.ExtendsRef{Name: "existing"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`exceeds AiGatewayQuota "team-quota": 4 models in namespace, limit is 3`))
+
+			By("lowering the model limit below the usage")
+			quota.Spec.MaxModels = ptr.To(int32(1))
+			Expect(k8sClient.Update(ctx, quota)).To(Succeed())
+			updated := existing.DeepCopy()
+			updated.Spec.AiModels = updated.Spec.AiModels[:1]
+			_, err = validator.ValidateUpdate(ctx, existing, updated)
+			Expect(err).NotTo(HaveOccurred())
+			updated.Spec.AiModels = append(existing.Spec.AiModels, gatewayv1alpha1.AiModel{Name: "gpt-4o", Provider: "openai"})
+			_, err = validator.ValidateUpdate(ctx, existing, updated)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`exceeds AiGatewayQuota "team-quota": 3 models in namespace, limit is 1`))
+		})
+
+		It("Should not count the replicas of suspended AiGateways against an AiGatewayQuota", func() {
+			By("creating an AiGatewayQuota and a suspended AiGateway")
+			quota := &gatewayv1alpha1.AiGatewayQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "replica-quota", Namespace: "default"},
+				Spec:       gatewayv1alpha1.AiGatewayQuotaSpec{MaxReplicas: ptr.To(int32(4))},
+			}
+			Expect(k8sClient.Create(ctx, quota)).To(Succeed())
+			suspended := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "suspended", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port:     4000,
+					AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4", Provider: "openai"}},
+					Replicas: ptr.To(int32(3)),
+					Suspend:  true,
+				},
+			}
+			Expect(k8sClient.Create(ctx, suspended)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, suspended)).To(Succeed())
+				Expect(k8sClient.Delete(ctx, quota)).To(Succeed())
+			})
+
+			By("creating a second AiGateway using the full quota")
+			obj.Name = "second"
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}}
+			obj.Spec.Replicas = ptr.To(int32(4))
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("resuming the suspended AiGateway with more replicas than the quota allows")
+			resumed := suspended.DeepCopy()
+			resumed.Spec.Suspend = false
+			resumed.Spec.Replicas = ptr.To(int32(5))
+			_, err = validator.ValidateUpdate(ctx, suspended, resumed)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`exceeds AiGatewayQuota "replica-quota": 5 replicas in namespace, limit is 4`))
+		})
+
+		It("Should deny providers not allowed by the AiGatewayClass", func() {
+			By("creating an AiGatewayClass allowing only OpenAI and Azure")
+			aiGatewayClass := &gatewayv1alpha1.AiGatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewayClassSpec{
+					Controller:       "test-controller",
+					AllowedProviders: []string{"openai", "azure"},
+				},
+			}
+			Expect(k8sClient.Create(ctx, aiGatewayClass)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, aiGatewayClass)).To(Succeed())
+			})
+
+			By("creating an AiGateway of the class using an allowed provider")
+			obj.Namespace = "default"
+			obj.Spec.AiGatewayClassName = "restricted"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("adding a model of another provider")
+			obj.Spec.AiModels = append(obj.Spec.AiModels,
+				gatewayv1alpha1.AiModel{Name: "claude-3-5-sonnet", Provider: "anthropic"})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.aiModels[1]: Forbidden: provider "anthropic" of model ` +
+				`"claude-3-5-sonnet" is not allowed by AiGatewayClass "restricted", allowed providers are openai, azure`))
+
+			By("creating a class of the same name without restrictions in the namespace of the gateway")
+			Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}))).To(Succeed())
+			shadow := &gatewayv1alpha1.AiGatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "restricted", Namespace: "team-a"},
+				Spec:       gatewayv1alpha1.AiGatewayClassSpec{Controller: "test-controller"},
+			}
+			Expect(k8sClient.Create(ctx, shadow)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, shadow)).To(Succeed())
+			})
+			obj.Namespace = "team-a"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`is not allowed by AiGatewayClass "restricted"`))
+		})
+
+		It("Should deny more models than the AiGatewayClass allows", func() {
+			By("creating an AiGatewayClass allowing two models per gateway")
+			aiGatewayClass := &gatewayv1alpha1.AiGatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "small", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewayClassSpec{
+					Controller:          "test-controller",
+					MaxModelsPerGateway: ptr.To(int32(2)),
+				},
+			}
+			Expect(k8sClient.Create(ctx, aiGatewayClass)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, aiGatewayClass)).To(Succeed())
+			})
+
+			obj.Namespace = "default"
+			obj.Spec.AiGatewayClassName = "small"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "claude-3-5-sonnet", Provider: "anthropic"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("adding a third model")
+			obj.Spec.AiModels = append(obj.Spec.AiModels,
+				gatewayv1alpha1.AiModel{Name: "mistral-large", Provider: "mistral"})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.aiModels: Forbidden: the gateway has 3 models including ` +
+				`imported and inherited models, AiGatewayClass "small" allows at most 2 (spec.maxModelsPerGateway)`))
+		})
+
+		It("Should warn about models referencing missing Secrets", func() {
+			By("creating an AiGateway with a Vertex AI model whose key Secret does not exist")
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{
+				Name:     "gemini-1.5-pro",
+				Provider: "vertex_ai",
+				VertexAI: &gatewayv1alpha1.VertexAIConfig{
+					Project:         "my-project",
+					Location:        "europe-west4",
+					CredentialsMode: gatewayv1alpha1.VertexCredentialsServiceAccountKey,
+					CredentialsSecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "vertex-key"},
+						Key:                  "key.json",
+					},
+				},
+			}}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(`AI model "gemini-1.5-pro" references Secret "vertex-key", which does ` +
+				`not exist in namespace "default"; requests to the model will fail until it is created`))
+
+			By("creating the Secret")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "vertex-key", Namespace: "default"},
+				StringData: map[string]string{"key.json": "{}"},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			})
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should not warn about storing the configuration of a gateway referencing Secrets in a ConfigMap", func() {
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.ConfigStorage = gatewayv1alpha1.ConfigStorageConfigMap
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}}
+			obj.Spec.MasterKeySecretRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "master-key"},
+				Key:                  "masterKey",
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).NotTo(ContainElement(ContainSubstring("spec.configStorage")))
+		})
+
+		It("Should validate the SecretProviderClass reference", func() {
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}}
+			obj.Spec.SecretProviderClassRef = &gatewayv1alpha1.SecretProviderClassRef{
+				Name:             "vault",
+				MountPath:        "secrets",
+				SyncedSecretName: "Provider_Keys",
 			}
 			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.secretProviderClassRef.mountPath"))
+			Expect(err.Error()).To(ContainSubstring("spec.secretProviderClassRef.syncedSecretName"))
+
+			By("referencing a SecretProviderClass that does not exist")
+			obj.Spec.SecretProviderClassRef.MountPath = "/mnt/secrets"
+			obj.Spec.SecretProviderClassRef.SyncedSecretName = "provider-keys"
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(And(
+				ContainSubstring(`SecretProviderClass "vault"`), ContainSubstring("the gateway pods will not start"))))
+		})
+
+		It("Should warn about API keys rejected by the provider", func() {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				if r.Header.Get("Authorization") != "Bearer valid" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				_, _ = w.Write([]byte(`{"data": [{"id": "gpt-4o"}]}`))
+			}))
+			DeferCleanup(server.Close)
+			validator.modelLister = func(provider, apiKey string) (providers.ModelLister, bool) {
+				return providers.NewModelLister(provider, server.URL+"/v1", apiKey)
+			}
+
+			By("creating an AiGateway with a model whose API key is invalid")
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "openai-keys", Namespace: "default"},
+				Data:       map[string][]byte{"valid": []byte("valid"), "invalid": []byte("invalid")},
+			}
+			Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+			})
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{
+				Name:     "gpt-4o",
+				Provider: "openai",
+				APIKeySecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "openai-keys"},
+					Key:                  "invalid",
+				},
+			}}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty(), "API keys are only checked if enabled")
+
+			validator.VerifyCredentials = true
+			validator.APIReader = k8sClient
+			warnings, err = validator.ValidateCreate(ctx, obj)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring(`AI model "gpt-4o": provider openai rejected the API key ` +
+				`in key "invalid" of Secret "openai-keys"`)))
+
+			By("referencing a valid API key")
+			obj.Spec.AiModels[0].APIKeySecretRef.Key = "valid"
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			By("setting an apiBase, which must never receive the key")
+			obj.Spec.AiModels[0].APIBase = server.URL + "/v1"
+			obj.Spec.AiModels[0].APIKeySecretRef.Key = "invalid"
+			requests.Store(0)
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+			Expect(requests.Load()).To(BeZero())
+		})
+
+		It("Should validate the settings ConfigMap", func() {
+			By("referencing a settings ConfigMap that does not exist yet")
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{{Name: "gpt-4", Provider: "openai"}}
+			obj.Spec.SettingsFrom = &gatewayv1alpha1.SettingsFrom{
+				ConfigMapRef: corev1.LocalObjectReference{Name: "litellm-settings"},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring(
+				`settings ConfigMap "litellm-settings" does not exist in namespace "default"`)))
+
+			By("creating the ConfigMap with invalid YAML")
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "litellm-settings", Namespace: "default"},
+				Data:       map[string]string{gatewayv1alpha1.SettingsKeyRouter: "routing_strategy: [least-busy"},
+			}
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+			})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.settingsFrom.configMapRef.name"))
+			Expect(err.Error()).To(ContainSubstring("router_settings must be a YAML mapping"))
+
+			By("fixing the YAML")
+			configMap.Data[gatewayv1alpha1.SettingsKeyRouter] = "routing_strategy: least-busy"
+			Expect(k8sClient.Update(ctx, configMap)).To(Succeed())
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			By("setting a literal credential")
+			configMap.Data[gatewayv1alpha1.SettingsKeyRouter] = "redis_password: hunter2"
+			Expect(k8sClient.Update(ctx, configMap)).To(Succeed())
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("settings router_settings.redis_password hold literal credentials"))
+
+			By("referencing an environment variable")
+			configMap.Data[gatewayv1alpha1.SettingsKeyRouter] = "redis_password: os.environ/REDIS_PASSWORD"
+			Expect(k8sClient.Update(ctx, configMap)).To(Succeed())
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("omitting the name of the ConfigMap")
+			obj.Spec.SettingsFrom.ConfigMapRef.Name = ""
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.settingsFrom.configMapRef.name: Required value"))
+		})
+
+		It("Should validate JWT authentication", func() {
+			By("creating an AiGateway with JWT authentication")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Auth = &gatewayv1alpha1.AuthConfig{
+				JWT: &gatewayv1alpha1.JWTAuth{
+					Issuer:    "https://login.example.com/realms/ai",
+					Audiences: []string{"ai-gateway"},
+					JWKSURI:   "https://login.example.com/realms/ai/certs",
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("additionally referencing a JWKS secret")
+			obj.Spec.Auth.JWT.JWKSSecretRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "jwks"},
+				Key:                  "jwks.json",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("jwksSecretRef cannot be combined with jwksURI"))
+
+			By("using a plain http issuer")
+			obj.Spec.Auth.JWT.JWKSSecretRef = nil
+			obj.Spec.Auth.JWT.Issuer = "http://login.example.com"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.auth.jwt.issuer"))
+		})
+
+		It("Should warn that an existing master key cannot be rotated", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Annotations = map[string]string{gatewayv1alpha1.RotateMasterKeyAnnotation: "1"}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			obj.Spec.MasterKeySecretRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "master-key"},
+				Key:                  "key",
+			}
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("must be rotated by its owner")))
+		})
+
+		It("Should validate the egress proxy settings", func() {
+			By("creating an AiGateway behind a proxy")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Egress = &gatewayv1alpha1.EgressConfig{
+				HTTPSProxy: "http://proxy.corp.example.com:3128",
+				NoProxy:    []string{".svc.cluster.local", "10.0.0.0/8"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("using a proxy without scheme")
+			obj.Spec.Egress.HTTPSProxy = "proxy.corp.example.com:3128"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.egress.httpsProxy"))
+
+			By("listing several hosts in one noProxy entry")
+			obj.Spec.Egress.HTTPSProxy = ""
+			obj.Spec.Egress.NoProxy = []string{"localhost,.svc"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be a single non-empty host, domain or CIDR"))
+		})
+
+		It("Should require exactly one source for the CA bundle", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Egress = &gatewayv1alpha1.EgressConfig{
+				CABundleRef: &gatewayv1alpha1.ContentSource{
+					ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "corp-ca"},
+						Key:                  "ca.crt",
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Egress.CABundleRef.SecretKeyRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "corp-ca"},
+				Key:                  "ca.crt",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.egress.caBundleRef"))
+		})
+
+		It("Should validate the priority and runtime class names", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.PriorityClassName = "system-cluster-critical"
+			obj.Spec.RuntimeClassName = ptr.To("gvisor")
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.RuntimeClassName = ptr.To("gVisor")
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.runtimeClassName"))
+		})
+
+		It("Should validate the autoscaling replica limits", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Autoscaling = &gatewayv1alpha1.AutoscalingConfig{
+				MinReplicas:                    ptr.To(int32(2)),
+				MaxReplicas:                    5,
+				TargetCPUUtilizationPercentage: ptr.To(int32(70)),
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("spec.redisRef is not set")))
+
+			obj.Spec.Autoscaling.MinReplicas = ptr.To(int32(6))
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not be greater than maxReplicas"))
+		})
+
+		It("Should validate KEDA autoscaling", func() {
+			By("creating an AiGateway scaled by KEDA")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Autoscaling = &gatewayv1alpha1.AutoscalingConfig{
+				MaxReplicas: 10,
+				KEDA: &gatewayv1alpha1.KEDAConfig{
+					ServerAddress: "http://prometheus.monitoring.svc:9090",
+					Triggers: []gatewayv1alpha1.KEDAPrometheusTrigger{
+						{Name: "in-flight", Query: "sum(litellm_in_flight_requests)", Threshold: "50"},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("combining KEDA with a CPU target")
+			obj.Spec.Autoscaling.TargetCPUUtilizationPercentage = ptr.To(int32(80))
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("keda cannot be combined with utilization targets or metrics"))
+
+			By("using an invalid threshold")
+			obj.Spec.Autoscaling.TargetCPUUtilizationPercentage = nil
+			obj.Spec.Autoscaling.KEDA.Triggers[0].Threshold = "fifty"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.autoscaling.keda.triggers[0].threshold"))
+		})
+
+		It("Should require a positive idle period for scale to zero", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.ScaleToZero = &gatewayv1alpha1.ScaleToZeroConfig{
+				IdleAfter: metav1.Duration{Duration: 30 * time.Minute},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.ScaleToZero.IdleAfter.Duration = 0
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.scaleToZero.idleAfter"))
+		})
+
+		It("Should validate scaling schedules", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Schedules = []gatewayv1alpha1.ScalingSchedule{{
+				Name:     "business-hours",
+				Start:    "0 8 * * 1-5",
+				Duration: metav1.Duration{Duration: 10 * time.Hour},
+				Replicas: 3,
+				TimeZone: "Europe/Berlin",
+			}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("adding an invalid schedule with the same name")
+			obj.Spec.Schedules = append(obj.Spec.Schedules, gatewayv1alpha1.ScalingSchedule{
+				Name:     "business-hours",
+				Start:    "0 8 * * MON",
+				TimeZone: "Europe/Atlantis",
+			})
+			obj.Spec.Autoscaling = &gatewayv1alpha1.AutoscalingConfig{MaxReplicas: 5}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.schedules: Forbidden"))
+			Expect(err.Error()).To(ContainSubstring(`spec.schedules[1].name: Duplicate value: "business-hours"`))
+			Expect(err.Error()).To(ContainSubstring(`spec.schedules[1].start`))
+			Expect(err.Error()).To(ContainSubstring(`spec.schedules[1].duration`))
+			Expect(err.Error()).To(ContainSubstring(`spec.schedules[1].timeZone`))
+		})
+
+		It("Should validate probe overrides", func() {
+			By("creating an AiGateway with a slow startup probe")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Probes = &gatewayv1alpha1.ProbesConfig{
+				Startup: &gatewayv1alpha1.ProbeSettings{
+					Path:             "/health/liveliness",
+					PeriodSeconds:    ptr.To(int32(10)),
+					FailureThreshold: ptr.To(int32(30)),
+				},
+				Readiness: &gatewayv1alpha1.ProbeSettings{SuccessThreshold: ptr.To(int32(2))},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("setting a success threshold on the liveness probe")
+			obj.Spec.Probes.Liveness = &gatewayv1alpha1.ProbeSettings{SuccessThreshold: ptr.To(int32(2))}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be 1 for liveness and startup probes"))
+
+			By("using a relative path")
+			obj.Spec.Probes.Liveness = &gatewayv1alpha1.ProbeSettings{Path: "health"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.probes.liveness.path"))
+		})
+
+		It("Should validate the update strategy", func() {
+			By("creating an AiGateway with a zero-downtime rolling update")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.UpdateStrategy = &appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				},
+			}
+			obj.Spec.TerminationGracePeriodSeconds = ptr.To(int64(120))
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("disallowing both surge and unavailability")
+			obj.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromString("0%"))
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("may not be 0 when maxSurge is 0"))
+
+			By("setting rolling update parameters for a recreate strategy")
+			obj.Spec.UpdateStrategy.Type = appsv1.RecreateDeploymentStrategyType
+			obj.Spec.UpdateStrategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromInt32(1))
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`may not be specified when strategy type is "Recreate"`))
+		})
+
+		It("Should validate the labels and annotations of the resource template", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Template = &gatewayv1alpha1.ResourceTemplate{
+				Metadata: gatewayv1alpha1.ResourceMetadata{
+					Labels:      map[string]string{"cost-center": "ai-platform"},
+					Annotations: map[string]string{"sidecar.istio.io/inject": "true"},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Template.Metadata.Labels["cost center"] = "ai-platform"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.template.metadata.labels"))
+		})
+
+		It("Should warn that detailed request logging may log sensitive data", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Logging = &gatewayv1alpha1.LoggingConfig{
+				Level:            gatewayv1alpha1.LogLevelDebug,
+				Format:           gatewayv1alpha1.LogFormatJSON,
+				DetailedRequests: true,
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(ContainSubstring("may contain sensitive data")))
+		})
+
+		It("Should validate the redaction patterns", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Logging = &gatewayv1alpha1.LoggingConfig{
+				DetailedRequests: true,
+				Redaction: &gatewayv1alpha1.RedactionPolicy{
+					Prompts:   true,
+					Responses: true,
+					Patterns:  []string{`[\w.+-]+@[\w-]+\.[\w.]+`},
+				},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			obj.Spec.Logging.Redaction.Patterns = append(obj.Spec.Logging.Redaction.Patterns, "KD-[0-9")
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.logging.redaction.patterns[1]"))
+		})
+
+		It("Should validate the audit sink", func() {
+			By("creating an AiGateway auditing to S3")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Audit = &gatewayv1alpha1.AuditConfig{
+				Provider:             gatewayv1alpha1.AuditStorageS3,
+				Bucket:               "ai-audit",
+				Region:               "eu-central-1",
+				CredentialsSecretRef: &corev1.LocalObjectReference{Name: "audit-credentials"},
+				SamplingPercent:      ptr.To(int32(10)),
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("setting a region for GCS")
+			obj.Spec.Audit.Provider = gatewayv1alpha1.AuditStorageGCS
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.audit.region: Forbidden: only allowed for provider "s3"`))
+		})
+
+		It("Should validate observability callbacks", func() {
+			By("creating an AiGateway with a Langfuse callback")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			langfuseKey := func(key string) corev1.SecretKeySelector {
+				return corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "langfuse"}, Key: key}
+			}
+			obj.Spec.Observability = &gatewayv1alpha1.ObservabilityConfig{
+				Callbacks: []gatewayv1alpha1.CallbackConfig{{
+					Type: gatewayv1alpha1.CallbackTypeLangfuse,
+					Langfuse: &gatewayv1alpha1.LangfuseCallback{
+						Host:               "https://langfuse.example.com",
+						PublicKeySecretRef: langfuseKey("public-key"),
+						SecretKeySecretRef: langfuseKey("secret-key"),
+					},
+				}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("declaring a webhook callback without a webhook block")
+			obj.Spec.Observability.Callbacks[0].Type = gatewayv1alpha1.CallbackTypeWebhook
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.observability.callbacks[0].webhook: Required value`))
+			Expect(err.Error()).To(ContainSubstring(`only allowed for type "Langfuse"`))
+		})
+
+		It("Should validate streaming settings", func() {
+			By("creating an AiGateway with a keepalive shorter than the stream timeout")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Streaming = &gatewayv1alpha1.StreamingConfig{
+				Mode:              gatewayv1alpha1.StreamingModeAuto,
+				Timeout:           &metav1.Duration{Duration: 10 * time.Minute},
+				KeepaliveInterval: &metav1.Duration{Duration: 15 * time.Second},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("setting a keepalive longer than the stream timeout")
+			obj.Spec.Streaming.KeepaliveInterval = &metav1.Duration{Duration: 15 * time.Minute}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be shorter than the stream timeout"))
+
+			By("setting a stream timeout with streaming disabled")
+			obj.Spec.Streaming.Mode = gatewayv1alpha1.StreamingModeDisable
+			obj.Spec.Streaming.KeepaliveInterval = nil
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.streaming.timeout: Forbidden: not allowed for mode "disable"`))
+		})
+
+		It("Should validate the circuit breaker", func() {
+			By("creating an AiGateway with a circuit breaker")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Resilience = &gatewayv1alpha1.ResilienceConfig{
+				CircuitBreaker: &gatewayv1alpha1.CircuitBreakerConfig{
+					ConsecutiveFailures: 3,
+					Cooldown:            &metav1.Duration{Duration: time.Minute},
+					HalfOpenProbes:      1,
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("setting a zero cooldown")
+			obj.Spec.Resilience.CircuitBreaker.Cooldown = &metav1.Duration{}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.resilience.circuitBreaker.cooldown: Invalid value"))
+		})
+
+		It("Should validate failover endpoints", func() {
+			By("creating an AiGateway with an Azure model failing over to another region")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4o",
+					Provider: "azure",
+					Azure:    &gatewayv1alpha1.AzureConfig{APIBase: "https://eu.openai.azure.com", APIVersion: "2024-06-01"},
+					FailoverEndpoints: []gatewayv1alpha1.FailoverEndpoint{
+						{Name: "us", APIBase: "https://us.openai.azure.com"},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("adding an endpoint with the reserved name and the primary API base")
+			obj.Spec.AiModels[0].FailoverEndpoints = append(obj.Spec.AiModels[0].FailoverEndpoints,
+				gatewayv1alpha1.FailoverEndpoint{Name: "primary", APIBase: "https://eu.openai.azure.com"})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the name is reserved for the primary endpoint"))
+			Expect(err.Error()).To(ContainSubstring("already used by spec.aiModels[0].azure.apiBase"))
+
+			By("using a plain http endpoint for an Azure model")
+			obj.Spec.AiModels[0].FailoverEndpoints[1] = gatewayv1alpha1.FailoverEndpoint{
+				Name: "us", APIBase: "http://us2.openai.azure.com",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.aiModels[0].failoverEndpoints[1].name: Duplicate value: "us"`))
+			Expect(err.Error()).To(ContainSubstring(`unsupported URL scheme "http"`))
+		})
+
+		It("Should validate declared model capabilities", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "llama3",
+					Provider: "ollama",
+					Capabilities: &gatewayv1alpha1.ModelCapabilities{
+						ContextWindow:     8192,
+						MaxOutputTokens:   16384,
+						SupportsStreaming: true,
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].capabilities.maxOutputTokens"))
+		})
+
+		It("Should validate per-request token limits", func() {
+			By("creating an AiGateway with gateway- and model-level token limits")
+			obj.Spec.Port = 4000
+			obj.Spec.RequestLimits = &gatewayv1alpha1.RequestTokenLimits{
+				MaxInputTokens:  ptr.To[int32](32000),
+				MaxOutputTokens: ptr.To[int32](4096),
+			}
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:          "gpt-4o",
+					Provider:      "openai",
+					RequestLimits: &gatewayv1alpha1.RequestTokenLimits{MaxOutputTokens: ptr.To[int32](1024)},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("setting non-positive limits")
+			obj.Spec.RequestLimits.MaxInputTokens = ptr.To[int32](0)
+			obj.Spec.AiModels[0].RequestLimits.MaxOutputTokens = ptr.To[int32](-1)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.requestLimits.maxInputTokens: Invalid value: 0"))
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].requestLimits.maxOutputTokens: Invalid value: -1"))
+		})
+
+		It("Should validate per-model parallelism and cooldown", func() {
+			By("creating an AiGateway with a parallel request limit and cooldown")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai", MaxParallelRequests: ptr.To[int32](10), CooldownSeconds: ptr.To[int32](60)},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("setting an invalid parallel request limit and cooldown")
+			obj.Spec.AiModels[0].MaxParallelRequests = ptr.To[int32](0)
+			obj.Spec.AiModels[0].CooldownSeconds = ptr.To[int32](-1)
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].maxParallelRequests"))
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].cooldownSeconds"))
+		})
+
+		It("Should validate header-based routing rules", func() {
+			By("creating an AiGateway routing enterprise tenants to a premium model")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o-mini", Provider: "openai", Alias: "default"},
+				{Name: "gpt-4o", Provider: "openai", Alias: "premium"},
+			}
+			obj.Spec.Routing = &gatewayv1alpha1.RoutingConfig{
+				Rules: []gatewayv1alpha1.RoutingRule{{
+					Headers: []gatewayv1alpha1.HeaderMatch{
+						{Name: "x-tenant", Type: gatewayv1alpha1.HeaderMatchExact, Value: "enterprise"},
+					},
+					RequestedModel: "default",
+					Model:          "premium",
+				}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("routing to an unknown model with an invalid header match")
+			obj.Spec.Routing.Rules[0].Model = "gpt-5"
+			obj.Spec.Routing.Rules[0].Headers[0] = gatewayv1alpha1.HeaderMatch{
+				Name: "x tenant", Type: gatewayv1alpha1.HeaderMatchRegularExpression, Value: "enterprise(",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.routing.rules[0].model: Not found: "gpt-5"`))
+			Expect(err.Error()).To(ContainSubstring("spec.routing.rules[0].headers[0].name"))
+			Expect(err.Error()).To(ContainSubstring("invalid regular expression"))
+		})
+
+		It("Should validate model mirrors", func() {
+			By("creating an AiGateway mirroring requests to a candidate model")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai", Mirror: &gatewayv1alpha1.MirrorConfig{
+					Model: "candidate", Percent: ptr.To[int32](10),
+				}},
+				{Name: "claude-3-5-sonnet", Provider: "anthropic", Alias: "candidate"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("mirroring a model to itself")
+			obj.Spec.AiModels[0].Mirror.Model = "gpt-4o"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("a model cannot be mirrored to itself"))
+
+			By("mirroring a model to an unknown model")
+			obj.Spec.AiModels[0].Mirror.Model = "gpt-5"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.aiModels[0].mirror.model: Not found: "gpt-5"`))
+		})
+
+		It("Should validate model groups", func() {
+			By("creating an AiGateway grouping two models with a fallback and shared rate limits")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "claude-3-5-sonnet", Provider: "anthropic", Alias: "claude"},
+				{Name: "mistral-large", Provider: "mistral"},
+			}
+			obj.Spec.ModelGroups = []gatewayv1alpha1.ModelGroup{{
+				Name:       "frontier",
+				Models:     []string{"gpt-4o", "claude"},
+				Fallbacks:  []string{"mistral-large"},
+				RateLimits: &gatewayv1alpha1.RateLimits{RequestsPerMinute: ptr.To[int32](600)},
+			}}
+			obj.Spec.Routing = &gatewayv1alpha1.RoutingConfig{
+				Rules: []gatewayv1alpha1.RoutingRule{{
+					Headers: []gatewayv1alpha1.HeaderMatch{{Name: "X-Tier", Value: "premium"}},
+					Model:   "frontier",
+				}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("naming the group like a model")
+			obj.Spec.ModelGroups[0].Name = "claude"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("name collides with the public name of a model"))
+
+			By("grouping an unknown model and falling back to the group itself")
+			obj.Spec.ModelGroups[0].Name = "frontier"
+			obj.Spec.ModelGroups[0].Models = []string{"gpt-4o", "claude-3-5-sonnet"}
+			obj.Spec.ModelGroups[0].Fallbacks = []string{"frontier"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.modelGroups[0].models[1]: Not found: "claude-3-5-sonnet"`))
+			Expect(err.Error()).To(ContainSubstring("a group cannot fall back to itself"))
+
+			By("nesting model groups without rate limits")
+			obj.Spec.ModelGroups[0].Models = []string{"gpt-4o"}
+			obj.Spec.ModelGroups[0].Fallbacks = nil
+			obj.Spec.ModelGroups[0].RateLimits = &gatewayv1alpha1.RateLimits{}
+			obj.Spec.ModelGroups = append(obj.Spec.ModelGroups, gatewayv1alpha1.ModelGroup{
+				Name:   "all",
+				Models: []string{"frontier", "mistral-large"},
+			})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("model groups cannot be nested"))
+			Expect(err.Error()).To(ContainSubstring(
+				"one of requestsPerMinute, tokensPerMinute or maxParallelRequests must be set"))
+		})
+
+		It("Should validate traffic splits", func() {
+			By("creating an AiGateway splitting an alias 90/10 between two models")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "claude-3-5-sonnet", Provider: "anthropic"},
+			}
+			obj.Spec.TrafficSplits = []gatewayv1alpha1.TrafficSplit{{
+				Alias: "chat",
+				Backends: []gatewayv1alpha1.SplitBackend{
+					{Model: "gpt-4o", Percent: 90},
+					{Model: "claude-3-5-sonnet", Percent: 10},
+				},
+			}}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("using a model name as alias with percentages not adding up to 100")
+			obj.Spec.TrafficSplits[0].Alias = "gpt-4o"
+			obj.Spec.TrafficSplits[0].Backends[1].Percent = 20
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("alias collides with the public name of a model or model group"))
+			Expect(err.Error()).To(ContainSubstring("percentages must add up to 100"))
+		})
+
+		It("Should validate pre- and post-call hooks", func() {
+			By("creating an AiGateway with a pre-call hook")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Hooks = &gatewayv1alpha1.HooksConfig{
+				PreCall: &gatewayv1alpha1.HookEndpoint{
+					URL: "http://prompt-filter.default.svc:8080/pre-call",
+					AuthorizationSecretRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "hook-auth"},
+						Key:                  "token",
+					},
+					FailurePolicy: gatewayv1alpha1.HookFailurePolicyFail,
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("adding a post-call hook with an invalid URL and timeout")
+			obj.Spec.Hooks.PostCall = &gatewayv1alpha1.HookEndpoint{
+				URL:     "ftp://recorder.default.svc",
+				Timeout: &metav1.Duration{},
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.hooks.postCall.url"))
+			Expect(err.Error()).To(ContainSubstring("spec.hooks.postCall.timeout"))
+		})
+
+		It("Should validate MCP servers", func() {
+			By("creating an AiGateway with an in-cluster and an external MCP server")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.MCPServers = []gatewayv1alpha1.MCPServer{
+				{Name: "github", URL: "https://api.githubcopilot.com/mcp/", Transport: gatewayv1alpha1.MCPTransportStreamableHTTP},
+				{Name: "search", BackendRef: &gatewayv1alpha1.BackendServiceRef{Name: "search-mcp", Port: 8000, Path: "/mcp"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("registering a server twice and a server without endpoint")
+			obj.Spec.MCPServers = append(obj.Spec.MCPServers, gatewayv1alpha1.MCPServer{Name: "github"})
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.mcpServers[2].name: Duplicate value"))
+			Expect(err.Error()).To(ContainSubstring("one of url or backendRef must be set"))
+		})
+
+		It("Should validate the A2A endpoint", func() {
+			By("creating an AiGateway exposing an A2A endpoint")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Protocols = &gatewayv1alpha1.ProtocolsConfig{
+				A2A: &gatewayv1alpha1.A2AProtocol{Path: "/a2a", Model: "gpt-4"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("serving the A2A endpoint below the OpenAI-compatible API")
+			obj.Spec.Protocols.A2A.Path = "/v1/a2a"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.protocols.a2a.path"))
+		})
+
+		It("Should validate the discovery namespace selector", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.Discovery = &gatewayv1alpha1.DiscoveryConfig{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "agentic-layer.ai/agents", Operator: metav1.LabelSelectorOpIn},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.discovery.namespaceSelector.matchExpressions[0].values"))
+		})
+
+		It("Should validate imported model libraries", func() {
+			By("creating an AiModelLibrary")
+			library := &gatewayv1alpha1.AiModelLibrary{
+				ObjectMeta: metav1.ObjectMeta{Name: "platform-models"},
+				Spec: gatewayv1alpha1.AiModelLibrarySpec{Models: []gatewayv1alpha1.AiModel{
+					{Name: "gpt-4o", Provider: "openai"},
+					{Name: "claude-3-5-sonnet", Provider: "anthropic"},
+				}},
+			}
+			Expect(k8sClient.Create(ctx, library)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, library)).To(Succeed())
+			})
+
+			By("creating an AiGateway importing a model of the library")
+			obj.Spec.Port = 4000
+			obj.Spec.ModelLibraries = []gatewayv1alpha1.ModelLibraryImport{
+				{Name: "platform-models", Models: []string{"gpt-4o"}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("importing a model the library does not contain")
+			obj.Spec.ModelLibraries[0].Models = append(obj.Spec.ModelLibraries[0].Models, "gpt-5")
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.modelLibraries[0].models[1]: Not found: "gpt-5"`))
+
+			By("importing a library that does not exist")
+			obj.Spec.ModelLibraries[0] = gatewayv1alpha1.ModelLibraryImport{Name: "team-models"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.modelLibraries[0].name: Not found: "team-models"`))
+		})
+
+		It("Should validate the baselines of a gateway", func() {
+			By("creating a platform baseline that extends the gateway under test")
+			baseline := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "platform-baseline", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port:     4000,
+					AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, baseline)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, baseline)).To(Succeed())
+			})
+
+			By("extending the baseline without own models")
+			obj.Name = "team-gateway"
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.Extends = &gatewayv1alpha1.ExtendsRef{Name: "platform-baseline"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("letting the baseline extend the gateway")
+			baseline.Spec.Extends = &gatewayv1alpha1.ExtendsRef{Name: "team-gateway"}
+			Expect(k8sClient.Update(ctx, baseline)).To(Succeed())
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("baselines must not form a cycle"))
+
+			By("extending a baseline that does not exist")
+			obj.Spec.Extends.Name = "missing-baseline"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.extends: Not found: "AiGateway default/missing-baseline"`))
+		})
+
+		It("Should only allow extending baselines of other namespaces that opt in", func() {
+			By("creating a baseline in another namespace")
+			Expect(client.IgnoreAlreadyExists(k8sClient.Create(ctx,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "platform"}}))).To(Succeed())
+			baseline := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-baseline", Namespace: "platform"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port:     4000,
+					AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, baseline)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(k8sClient.Delete(ctx, baseline)).To(Succeed())
+			})
+
+			By("extending it without its consent")
+			obj.Name = "team-gateway"
+			obj.Namespace = "default"
+			obj.Spec.Port = 4000
+			obj.Spec.Extends = &gatewayv1alpha1.ExtendsRef{Name: "shared-baseline", Namespace: "platform"}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.extends: Forbidden: AiGateway platform/shared-baseline ` +
+				`cannot be extended from namespace "default"; list it in the agentic-layer.ai/extendable-from ` +
+				`annotation of the baseline`))
+
+			By("letting the baseline opt in")
+			baseline.Annotations = map[string]string{gatewayv1alpha1.ExtendableFromAnnotation: "default"}
+			Expect(k8sClient.Update(ctx, baseline)).To(Succeed())
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny duplicate models", func() {
+			By("creating an AiGateway balancing a model across two endpoints")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "llama-3", Provider: "openai", APIBase: "http://vllm-a:8000/v1"},
+				{Name: "llama-3", Provider: "openai", APIBase: "http://vllm-b:8000/v1"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("listing the same deployment twice")
+			obj.Spec.AiModels[1].APIBase = "http://vllm-a:8000/v1"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				`spec.aiModels[1]: Duplicate value: "openai/llama-3 (same deployment as spec.aiModels[0])"`))
+		})
+
+		It("Should admit creation if all required fields are valid", func() {
+			By("creating a valid AiGateway")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+				{Name: "claude-3-opus", Provider: "anthropic"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should admit creation of an Azure model with a valid azure configuration", func() {
+			By("creating an AiGateway with an Azure OpenAI model")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4o",
+					Provider: "azure",
+					Azure: &gatewayv1alpha1.AzureConfig{
+						APIBase:        "https://my-resource.openai.azure.com",
+						APIVersion:     "2024-06-01",
+						DeploymentName: "gpt-4o-eu",
+						UseAzureAD:     true,
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation if azure configuration is set for a non-azure provider", func() {
+			By("creating an AiGateway with an azure block on an openai model")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4o",
+					Provider: "openai",
+					Azure: &gatewayv1alpha1.AzureConfig{
+						APIBase:    "https://my-resource.openai.azure.com",
+						APIVersion: "2024-06-01",
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].azure"))
+			Expect(err.Error()).To(ContainSubstring(`only allowed for provider "azure"`))
+		})
+
+		It("Should deny creation if azure configuration is incomplete or invalid", func() {
+			By("creating an AiGateway with an azure block missing apiBase and apiVersion")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "azure", Azure: &gatewayv1alpha1.AzureConfig{}},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Azure API base cannot be empty"))
+			Expect(err.Error()).To(ContainSubstring("Azure API version cannot be empty"))
+
+			By("creating an AiGateway with a non-https apiBase")
+			obj.Spec.AiModels[0].Azure = &gatewayv1alpha1.AzureConfig{
+				APIBase:    "http://my-resource.openai.azure.com",
+				APIVersion: "2024-06-01",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].azure.apiBase"))
+			Expect(err.Error()).To(ContainSubstring("unsupported URL scheme"))
+		})
+
+		It("Should admit creation with a gateway-level and a model-level system prefix", func() {
+			By("creating an AiGateway with system prefixes from a ConfigMap and a Secret")
+			obj.Spec.Port = 4000
+			obj.Spec.PromptPolicy = &gatewayv1alpha1.PromptPolicy{
+				SystemPrefix: &gatewayv1alpha1.SystemPromptInjection{
+					ContentSource: gatewayv1alpha1.ContentSource{
+						ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "org-prompts"},
+							Key:                  "safety",
+						},
+					},
+				},
+			}
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4",
+					Provider: "openai",
+					PromptPolicy: &gatewayv1alpha1.PromptPolicy{
+						SystemPrefix: &gatewayv1alpha1.SystemPromptInjection{
+							ContentSource: gatewayv1alpha1.ContentSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "gpt-4-prompts"},
+									Key:                  "system",
+								},
+							},
+							Placement: gatewayv1alpha1.PromptPlacementAppend,
+						},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation if a system prefix does not reference exactly one source", func() {
+			By("creating an AiGateway with a system prefix without a source")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.PromptPolicy = &gatewayv1alpha1.PromptPolicy{
+				SystemPrefix: &gatewayv1alpha1.SystemPromptInjection{},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("one of configMapKeyRef or secretKeyRef must be set"))
+
+			By("creating an AiGateway with a system prefix referencing both a ConfigMap and a Secret")
+			obj.Spec.PromptPolicy.SystemPrefix.ConfigMapKeyRef = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "org-prompts"},
+				Key:                  "safety",
+			}
+			obj.Spec.PromptPolicy.SystemPrefix.SecretKeyRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "org-prompts"},
+				Key:                  "safety",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only one of configMapKeyRef or secretKeyRef may be set"))
+
+			By("creating an AiGateway with a model-level system prefix missing the key")
+			obj.Spec.PromptPolicy = nil
+			obj.Spec.AiModels[0].PromptPolicy = &gatewayv1alpha1.PromptPolicy{
+				SystemPrefix: &gatewayv1alpha1.SystemPromptInjection{
+					ContentSource: gatewayv1alpha1.ContentSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "gpt-4-prompts"},
+						},
+					},
+				},
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].promptPolicy.systemPrefix.secretKeyRef.key"))
+
+			By("creating an AiGateway with a system prefix referencing both a Secret and a prompt template")
+			obj.Spec.AiModels[0].PromptPolicy.SystemPrefix.TemplateRef = &gatewayv1alpha1.PromptTemplateRef{
+				Name: "support-agent",
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].promptPolicy.systemPrefix.templateRef: Forbidden"))
+
+			By("creating an AiGateway enforcing a system prefix rendered from a prompt template")
+			obj.Spec.AiModels[0].PromptPolicy.SystemPrefix.SecretKeyRef = nil
+			obj.Spec.AiModels[0].PromptPolicy.SystemPrefix.Placement = gatewayv1alpha1.PromptPlacementReplace
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should admit creation of a Bedrock model using IRSA without warnings", func() {
+			By("creating an AiGateway with a Bedrock model and an IRSA annotated ServiceAccount")
+			obj.Spec.Port = 4000
+			obj.Spec.ServiceAccount = &gatewayv1alpha1.GatewayServiceAccount{
+				Annotations: map[string]string{
+					"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/ai-gateway",
+				},
+			}
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "anthropic.claude-3-5-sonnet-20240620-v1:0",
+					Provider: "bedrock",
+					Bedrock:  &gatewayv1alpha1.BedrockConfig{Region: "eu-central-1"},
+				},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should warn if a Bedrock model is used without an IRSA role", func() {
+			By("creating an AiGateway with a Bedrock model and no ServiceAccount configuration")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "anthropic.claude-3-5-sonnet-20240620-v1:0",
+					Provider: "bedrock",
+					Bedrock:  &gatewayv1alpha1.BedrockConfig{Region: "us-east-1"},
+				},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(HaveLen(1))
+			Expect(warnings[0]).To(ContainSubstring("static AWS credentials will be required"))
+		})
+
+		It("Should deny creation if the Bedrock configuration is invalid", func() {
+			By("creating an AiGateway with an invalid AWS region")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "anthropic.claude-3-5-sonnet-20240620-v1:0",
+					Provider: "bedrock",
+					Bedrock:  &gatewayv1alpha1.BedrockConfig{Region: "Frankfurt"},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be a valid AWS region"))
+
+			By("creating an AiGateway with a bedrock block on a non-bedrock model")
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4",
+					Provider: "openai",
+					Bedrock:  &gatewayv1alpha1.BedrockConfig{Region: "us-east-1"},
+				},
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`only allowed for provider "bedrock"`))
+		})
+
+		It("Should admit creation of a Vertex AI model using Workload Identity", func() {
+			By("creating an AiGateway with a Vertex AI model and a Workload Identity annotated ServiceAccount")
+			obj.Spec.Port = 4000
+			obj.Spec.ServiceAccount = &gatewayv1alpha1.GatewayServiceAccount{
+				Annotations: map[string]string{
+					"iam.gke.io/gcp-service-account": "ai-gateway@my-project.iam.gserviceaccount.com",
+				},
+			}
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gemini-1.5-pro",
+					Provider: "vertex_ai",
+					VertexAI: &gatewayv1alpha1.VertexAIConfig{
+						Project:  "my-project",
+						Location: "europe-west4",
+					},
+				},
+			}
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			By("removing the Workload Identity annotation")
+			obj.Spec.ServiceAccount = nil
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("iam.gke.io/gcp-service-account")))
+		})
+
+		It("Should deny creation if the Vertex AI configuration is missing or inconsistent", func() {
+			By("creating an AiGateway with a vertex_ai model without configuration")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gemini-1.5-pro", Provider: "vertex_ai"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("vertexAI configuration is required"))
+
+			By("creating an AiGateway using ServiceAccountKey credentials without a Secret")
+			obj.Spec.AiModels[0].VertexAI = &gatewayv1alpha1.VertexAIConfig{
+				Project:         "my-project",
+				Location:        "europe-west4",
+				CredentialsMode: gatewayv1alpha1.VertexCredentialsServiceAccountKey,
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("a credentials Secret is required"))
+
+			By("creating an AiGateway using Workload Identity together with a credentials Secret")
+			obj.Spec.AiModels[0].VertexAI = &gatewayv1alpha1.VertexAIConfig{
+				Project:         "my-project",
+				Location:        "europe-west4",
+				CredentialsMode: gatewayv1alpha1.VertexCredentialsWorkloadIdentity,
+				CredentialsSecretRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "vertex-sa"},
+					Key:                  "key.json",
+				},
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("can only be used with ServiceAccountKey credentials"))
+		})
+
+		It("Should deny creation if ServiceAccount annotations are set for an existing ServiceAccount", func() {
+			By("creating an AiGateway with both serviceAccount name and annotations")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4", Provider: "openai"},
+			}
+			obj.Spec.ServiceAccount = &gatewayv1alpha1.GatewayServiceAccount{
+				Name:        "existing-sa",
+				Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/x"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.serviceAccount.annotations"))
+		})
+
+		It("Should admit creation of wildcard models", func() {
+			By("creating an AiGateway passing through all OpenAI and all Claude models")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "*", Provider: "openai"},
+				{Name: "claude-*", Provider: "anthropic"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.Spec.AiModels[0].IsWildcard()).To(BeTrue())
+		})
+
+		It("Should deny creation if a model name is malformed", func() {
+			By("creating an AiGateway with a wildcard in the middle of the name")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-*-turbo", Provider: "openai"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only allowed as the last character"))
+
+			By("creating an AiGateway with whitespace in the name")
+			obj.Spec.AiModels[0].Name = "gpt 4"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must not contain whitespace"))
+
+			By("creating an AiGateway with an alias on a wildcard model")
+			obj.Spec.AiModels[0] = gatewayv1alpha1.AiModel{Name: "*", Provider: "openai", Alias: "all-openai"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("wildcard models cannot have an alias"))
+		})
+
+		It("Should admit creation of models with distinct aliases", func() {
+			By("creating an AiGateway exposing provider deployments under stable aliases")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o-eu", Provider: "azure", Alias: "gpt-4o", Azure: &gatewayv1alpha1.AzureConfig{
+					APIBase: "https://eu.openai.azure.com", APIVersion: "2024-06-01",
+				}},
+				{Name: "claude-3-opus", Provider: "anthropic"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should admit deployments of the same model sharing the alias Normalize keeps", func() {
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "gpt-4o", Provider: "azure", Azure: &gatewayv1alpha1.AzureConfig{
+					APIBase: "https://eu.openai.azure.com", APIVersion: "2024-06-01",
+				}},
+			}
+			Expect(defaulter.Default(ctx, obj)).To(Succeed())
+			Expect(obj.PublicModelNames()).To(Equal([]string{"gpt-4o"}))
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation if aliases collide", func() {
+			By("creating an AiGateway with two models using the same alias")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai", Alias: "default"},
+				{Name: "claude-3-opus", Provider: "anthropic", Alias: "default"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[1].alias: Duplicate value"))
+
+			By("creating an AiGateway with an alias shadowing another model name")
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "gpt-4o", Provider: "openai"},
+				{Name: "claude-3-opus", Provider: "anthropic", Alias: "gpt-4o"},
+			}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("alias collides with the name of spec.aiModels[0]"))
+		})
+
+		It("Should admit creation of a model with a custom API base", func() {
+			By("creating an AiGateway with an OpenAI-compatible endpoint")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "mixtral", Provider: "openai", APIBase: "https://llm.example.com/v1"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation if the custom API base is invalid or ambiguous", func() {
+			By("creating an AiGateway with a relative API base")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{Name: "mixtral", Provider: "openai", APIBase: "llm.example.com/v1"},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("must be an absolute URL"))
+
+			By("creating an AiGateway with an unsupported URL scheme")
+			obj.Spec.AiModels[0].APIBase = "ftp://llm.example.com/v1"
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported URL scheme"))
+
+			By("creating an AiGateway with both an API base and a backend Service")
+			obj.Spec.AiModels[0].APIBase = "https://llm.example.com/v1"
+			obj.Spec.AiModels[0].BackendRef = &gatewayv1alpha1.BackendServiceRef{Name: "vllm", Port: 8000}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("apiBase cannot be combined with backendRef"))
+		})
+
+		It("Should admit creation of a model served by an in-cluster Service", func() {
+			By("creating an AiGateway with an Ollama backend Service")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "llama3",
+					Provider: "ollama",
+					BackendRef: &gatewayv1alpha1.BackendServiceRef{
+						Name:      "ollama",
+						Namespace: "models",
+						Port:      11434,
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation if the backend Service reference is invalid", func() {
+			By("creating an AiGateway with an invalid backend Service reference")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "llama3",
+					Provider: "hosted_vllm",
+					BackendRef: &gatewayv1alpha1.BackendServiceRef{
+						Name: "vLLM_Server",
+						Port: 0,
+						Path: "v1",
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].backendRef.name"))
+			Expect(err.Error()).To(ContainSubstring("spec.aiModels[0].backendRef.port"))
+			Expect(err.Error()).To(ContainSubstring("must start with '/'"))
+		})
+
+		It("Should admit creation with a guardrail exemption for trusted consumers", func() {
+			By("creating an AiGateway exempting a red-team key from a guardrail")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4",
+					Provider: "openai",
+					Guardrails: &gatewayv1alpha1.GuardrailPolicy{
+						Exemptions: []gatewayv1alpha1.GuardrailExemption{
+							{
+								Guardrails: []string{"prompt-injection"},
+								Consumers: gatewayv1alpha1.ConsumerSelector{
+									KeyAliases: []string{"red-team"},
+									Teams:      []string{"security"},
+								},
+								Reason: "internal red-team testing",
+							},
+						},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny creation if a guardrail exemption is incomplete", func() {
+			By("creating an AiGateway with an empty guardrail exemption")
+			obj.Spec.Port = 4000
+			obj.Spec.AiModels = []gatewayv1alpha1.AiModel{
+				{
+					Name:     "gpt-4",
+					Provider: "openai",
+					Guardrails: &gatewayv1alpha1.GuardrailPolicy{
+						Exemptions: []gatewayv1alpha1.GuardrailExemption{{}},
+					},
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("at least one guardrail must be listed"))
+			Expect(err.Error()).To(ContainSubstring("at least one key alias or team must be selected"))
+			Expect(err.Error()).To(ContainSubstring("a reason is required for the audit log"))
 		})
 
 		It("Should validate updates correctly", func() {
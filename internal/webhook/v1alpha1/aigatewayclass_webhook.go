@@ -19,7 +19,11 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -32,23 +36,27 @@ import (
 )
 
 const (
-	DefaultClassAnnotation = "aigateway.kubernetes.io/is-default-class"
+	DefaultClassAnnotation = aigatewayv1alpha1.DefaultClassAnnotation
 )
 
 // nolint:unused
 // log is for logging in this package.
 var aiGatewayClassLog = logf.Log.WithName("aigatewayclass-resource")
 
-// SetupAiGatewayClassWebhookWithManager registers the webhook for AiGatewayClass in the manager.
-func SetupAiGatewayClassWebhookWithManager(mgr ctrl.Manager) error {
+// SetupAiGatewayClassWebhookWithManager registers the webhook for AiGatewayClass in the manager. If
+// allowMultipleDefaults is set, several classes may be marked as default and the webhook only warns about it.
+// Gateways only use the classes in classNamespace, see aigatewayv1alpha1.ResolveClass.
+func SetupAiGatewayClassWebhookWithManager(mgr ctrl.Manager, allowMultipleDefaults bool, classNamespace string) error {
 	return ctrl.NewWebhookManagedBy(mgr).For(&aigatewayv1alpha1.AiGatewayClass{}).
-		WithValidator(&AiGatewayClassCustomValidator{Client: mgr.GetClient()}).
+		WithValidator(&tracingValidator{kind: "AiGatewayClass", validator: &AiGatewayClassCustomValidator{
+			Client: tracingClient{mgr.GetClient()}, AllowMultipleDefaults: allowMultipleDefaults,
+			ClassNamespace: classNamespace}}).
 		Complete()
 }
 
 // NOTE: The 'path' attribute must follow a specific pattern and should not be modified directly here.
 // Modifying the path for an invalid path can cause API server errors; failing to locate the webhook.
-// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aigatewayclass,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aigatewayclasses,verbs=create;update,versions=v1alpha1,name=vaigatewayclass-v1alpha1.kb.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aigatewayclass,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aigatewayclasses,verbs=create;update;delete,versions=v1alpha1,name=vaigatewayclass-v1alpha1.kb.io,admissionReviewVersions=v1
 
 // AiGatewayClassCustomValidator struct is responsible for validating the AiGatewayClass resource
 // when it is created or updated.
@@ -57,6 +65,11 @@ func SetupAiGatewayClassWebhookWithManager(mgr ctrl.Manager) error {
 // as this struct is used only for temporary operations and does not need to be deeply copied.
 type AiGatewayClassCustomValidator struct {
 	Client client.Client
+	// AllowMultipleDefaults turns the denial of a second default class into a warning. The oldest default class
+	// is used then, see ResolveDefaultClass.
+	AllowMultipleDefaults bool
+	// ClassNamespace is the namespace of the classes gateways use, see ResolveClass.
+	ClassNamespace string
 }
 
 var _ webhook.CustomValidator = &AiGatewayClassCustomValidator{}
@@ -67,9 +80,11 @@ func (v *AiGatewayClassCustomValidator) ValidateCreate(ctx context.Context, obj
 	if !ok {
 		return nil, fmt.Errorf("expected a AiGatewayClass object but got %T", obj)
 	}
-	aiGatewayClassLog.Info("Validation for AiGatewayClass upon creation", "name", aiGatewayClass.GetName())
+	requestLog(ctx, aiGatewayClassLog, aiGatewayClass).Info("Validation for AiGatewayClass upon creation")
 
-	return v.validateAiGatewayClass(ctx, aiGatewayClass)
+	warnings, err := v.validateAiGatewayClass(ctx, aiGatewayClass)
+	recordValidation(ctx, "AiGatewayClass", aiGatewayClass, err)
+	return warnings, err
 }
 
 // ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiGatewayClass.
@@ -78,52 +93,203 @@ func (v *AiGatewayClassCustomValidator) ValidateUpdate(ctx context.Context, _, n
 	if !ok {
 		return nil, fmt.Errorf("expected a AiGatewayClass object for the newObj but got %T", newObj)
 	}
-	aiGatewayClassLog.Info("Validation for AiGatewayClass upon update", "name", aiGatewayClass.GetName())
+	requestLog(ctx, aiGatewayClassLog, aiGatewayClass).Info("Validation for AiGatewayClass upon update")
 
-	return v.validateAiGatewayClass(ctx, aiGatewayClass)
+	warnings, err := v.validateAiGatewayClass(ctx, aiGatewayClass)
+	recordValidation(ctx, "AiGatewayClass", aiGatewayClass, err)
+	return warnings, err
 }
 
 // ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiGatewayClass.
-func (v *AiGatewayClassCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
-	// No validation needed on delete
-	return nil, nil
+// It denies deleting a class that is still used by AiGateways or ClusterAiGateways, which would orphan them.
+func (v *AiGatewayClassCustomValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	aiGatewayClass, ok := obj.(*aigatewayv1alpha1.AiGatewayClass)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiGatewayClass object but got %T", obj)
+	}
+	requestLog(ctx, aiGatewayClassLog, aiGatewayClass).Info("Validation for AiGatewayClass upon deletion")
+
+	dependents, err := v.classDependents(ctx, aiGatewayClass)
+	if err != nil {
+		return nil, err
+	}
+	if len(dependents) > 0 {
+		err = fmt.Errorf("AiGatewayClass %q is still used by %s; delete them or change their aiGatewayClassName first",
+			aiGatewayClass.Name, strings.Join(dependents, ", "))
+	}
+	recordValidation(ctx, "AiGatewayClass", aiGatewayClass, err)
+	return nil, err
+}
+
+// classDependents returns the AiGateways, as "AiGateway <namespace>/<name>", and the ClusterAiGateways, as
+// "ClusterAiGateway <name>", whose aiGatewayClassName resolves to the class. A class of the same name in another
+// namespace has no dependents, as gateways never resolve to it.
+func (v *AiGatewayClassCustomValidator) classDependents(ctx context.Context,
+	aiGatewayClass *aigatewayv1alpha1.AiGatewayClass) ([]string, error) {
+	// ResolveClass compares namespace and name, so a gateway uses the class iff its class name resolves to it.
+	usesClass := func(className string) bool {
+		return aigatewayv1alpha1.ResolveClass([]aigatewayv1alpha1.AiGatewayClass{*aiGatewayClass}, className,
+			v.ClassNamespace) != nil
+	}
+
+	var dependents []string
+	var aiGateways aigatewayv1alpha1.AiGatewayList
+	if err := v.Client.List(ctx, &aiGateways); err != nil {
+		return nil, fmt.Errorf("failed to list AiGateway resources: %w", err)
+	}
+	for _, aiGateway := range aiGateways.Items {
+		if usesClass(aiGateway.Spec.AiGatewayClassName) {
+			dependents = append(dependents, "AiGateway "+aiGateway.Namespace+"/"+aiGateway.Name)
+		}
+	}
+
+	var clusterGateways aigatewayv1alpha1.ClusterAiGatewayList
+	if err := v.Client.List(ctx, &clusterGateways); err != nil {
+		return nil, fmt.Errorf("failed to list ClusterAiGateway resources: %w", err)
+	}
+	for _, gateway := range clusterGateways.Items {
+		if usesClass(gateway.Spec.AiGatewayClassName) {
+			dependents = append(dependents, "ClusterAiGateway "+gateway.Name)
+		}
+	}
+	return dependents, nil
 }
 
 // validateAiGatewayClass performs validation logic for AiGatewayClass resources.
 func (v *AiGatewayClassCustomValidator) validateAiGatewayClass(ctx context.Context, aiGatewayClass *aigatewayv1alpha1.AiGatewayClass) (admission.Warnings, error) {
 	var allErrs field.ErrorList
+	var warnings admission.Warnings
 
-	// Check if this AiGatewayClass has the default class annotation set to "true"
-	annotations := aiGatewayClass.GetAnnotations()
-	if annotations != nil && annotations[DefaultClassAnnotation] == "true" {
-		// List all existing AiGatewayClasses resources
+	if aiGatewayClass.Namespace != v.ClassNamespace {
+		warnings = append(warnings, fmt.Sprintf("AiGatewayClasses are only resolved in namespace %q; "+
+			"AiGateways cannot use this class", v.ClassNamespace))
+	}
+
+	// Check if this AiGatewayClass has the default class annotation set to "true". Only classes in the class
+	// namespace can be the default class.
+	if aiGatewayClass.IsDefault() && aiGatewayClass.Namespace == v.ClassNamespace {
+		// List all existing AiGatewayClasses resources of the class namespace
 		var aiGatewayClassList aigatewayv1alpha1.AiGatewayClassList
-		if err := v.Client.List(ctx, &aiGatewayClassList); err != nil {
+		if err := v.Client.List(ctx, &aiGatewayClassList, client.InNamespace(v.ClassNamespace)); err != nil {
 			return nil, fmt.Errorf("failed to list AiGatewayClass resources: %w", err)
 		}
 
-		// Check if any other AiGatewayClass already has the default annotation
-		for _, existingClass := range aiGatewayClassList.Items {
-			// Skip the current resource being validated
-			if existingClass.GetName() == aiGatewayClass.GetName() {
-				continue
+		if v.AllowMultipleDefaults {
+			warnings = append(warnings, multipleDefaultsWarnings(aiGatewayClass, aiGatewayClassList.Items,
+				v.ClassNamespace)...)
+		} else {
+			// Check if any other AiGatewayClass already has the default annotation
+			for _, existingClass := range aiGatewayClassList.Items {
+				// Skip the current resource being validated
+				if client.ObjectKeyFromObject(&existingClass) == client.ObjectKeyFromObject(aiGatewayClass) {
+					continue
+				}
+
+				if existingClass.IsDefault() {
+					allErrs = append(allErrs, field.Invalid(
+						field.NewPath("metadata", "annotations").Key(DefaultClassAnnotation),
+						"true",
+						fmt.Sprintf("another AiGatewayClass '%s' already has the default class annotation set to 'true'. Only one AiGatewayClass can be marked as default", existingClass.GetName()),
+					))
+					break
+				}
 			}
+		}
+	}
 
-			existingAnnotations := existingClass.GetAnnotations()
-			if existingAnnotations != nil && existingAnnotations[DefaultClassAnnotation] == "true" {
-				allErrs = append(allErrs, field.Invalid(
-					field.NewPath("metadata", "annotations").Key(DefaultClassAnnotation),
-					"true",
-					fmt.Sprintf("another AiGatewayClass '%s' already has the default class annotation set to 'true'. Only one AiGatewayClass can be marked as default", existingClass.GetName()),
-				))
-				break
+	if ref := aiGatewayClass.Spec.ParametersRef; ref != nil {
+		refPath := field.NewPath("spec", "parametersRef")
+		if ref.Kind == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("kind"), "kind cannot be empty"))
+		}
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), "name cannot be empty"))
+		}
+	}
+
+	if ref := aiGatewayClass.Spec.TemplatesRef; ref != nil {
+		refPath := field.NewPath("spec", "templatesRef")
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), "name cannot be empty"))
+		} else {
+			templateWarnings, errs, err := v.validateTemplates(ctx, aiGatewayClass, refPath)
+			if err != nil {
+				return nil, err
 			}
+			warnings = append(warnings, templateWarnings...)
+			allErrs = append(allErrs, errs...)
 		}
 	}
 
+	providers := make(map[string]bool)
+	for i, provider := range aiGatewayClass.Spec.AllowedProviders {
+		providerPath := field.NewPath("spec", "allowedProviders").Index(i)
+		switch {
+		case provider == "":
+			allErrs = append(allErrs, field.Required(providerPath, "provider cannot be empty"))
+		case providers[provider]:
+			allErrs = append(allErrs, field.Duplicate(providerPath, provider))
+		}
+		providers[provider] = true
+	}
+
 	if len(allErrs) > 0 {
-		return nil, allErrs.ToAggregate()
+		return warnings, allErrs.ToAggregate()
+	}
+
+	return warnings, nil
+}
+
+// multipleDefaultsWarnings warns if other classes than aiGatewayClass are marked as default as well, naming the
+// class AiGateways without aiGatewayClassName use.
+func multipleDefaultsWarnings(aiGatewayClass *aigatewayv1alpha1.AiGatewayClass,
+	classes []aigatewayv1alpha1.AiGatewayClass, classNamespace string) admission.Warnings {
+	candidate := aiGatewayClass.DeepCopy()
+	if candidate.CreationTimestamp.IsZero() {
+		// The class is being created.
+		candidate.CreationTimestamp = metav1.Now()
 	}
+	candidates := []aigatewayv1alpha1.AiGatewayClass{*candidate}
+	var others []string
+	for _, class := range classes {
+		if client.ObjectKeyFromObject(&class) != client.ObjectKeyFromObject(candidate) && class.IsDefault() {
+			candidates = append(candidates, class)
+			others = append(others, class.Name)
+		}
+	}
+	if len(others) == 0 {
+		return nil
+	}
+
+	defaultClass := aigatewayv1alpha1.ResolveDefaultClass(candidates, classNamespace)
+	if client.ObjectKeyFromObject(defaultClass) != client.ObjectKeyFromObject(candidate) {
+		return admission.Warnings{candidate.DefaultCondition(defaultClass).Message}
+	}
+	return admission.Warnings{fmt.Sprintf("AiGatewayClasses %s are marked as default as well; AiGateways without "+
+		"aiGatewayClassName use this class, as it is the oldest", strings.Join(others, ", "))}
+}
 
-	return nil, nil
+// validateTemplates parses the templates of the ConfigMap referenced by spec.templatesRef, so that syntax errors
+// are reported when the class is changed rather than when implementations render the gateways of the class.
+// A missing ConfigMap is only warned about, as it may be created after the class.
+func (v *AiGatewayClassCustomValidator) validateTemplates(ctx context.Context,
+	aiGatewayClass *aigatewayv1alpha1.AiGatewayClass, refPath *field.Path) (admission.Warnings, field.ErrorList, error) {
+	ref := aiGatewayClass.Spec.TemplatesRef
+	key := client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
+	if key.Namespace == "" {
+		key.Namespace = aiGatewayClass.Namespace
+	}
+
+	var configMap corev1.ConfigMap
+	if err := v.Client.Get(ctx, key, &configMap); apierrors.IsNotFound(err) {
+		return admission.Warnings{fmt.Sprintf("templates ConfigMap %q does not exist in namespace %q; "+
+			"implementations use their built-in resources until it is created", key.Name, key.Namespace)}, nil, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("failed to get templates ConfigMap %s: %w", key, err)
+	}
+
+	if _, err := aigatewayv1alpha1.ParseTemplates(configMap.Data); err != nil {
+		return nil, field.ErrorList{field.Invalid(refPath, ref.Name, err.Error())}, nil
+	}
+	return nil, nil, nil
 }
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	agenticlayeraiv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
 )
@@ -35,9 +37,9 @@ var _ = Describe("AiGatewayClass Webhook", func() {
 	)
 
 	BeforeEach(func() {
-		obj = &agenticlayeraiv1alpha1.AiGatewayClass{}
+		obj = &agenticlayeraiv1alpha1.AiGatewayClass{ObjectMeta: metav1.ObjectMeta{Namespace: "default"}}
 		oldObj = &agenticlayeraiv1alpha1.AiGatewayClass{}
-		validator = AiGatewayClassCustomValidator{Client: k8sClient}
+		validator = AiGatewayClassCustomValidator{Client: k8sClient, ClassNamespace: "default"}
 		Expect(validator).NotTo(BeNil(), "Expected validator to be initialized")
 		Expect(oldObj).NotTo(BeNil(), "Expected oldObj to be initialized")
 		Expect(obj).NotTo(BeNil(), "Expected obj to be initialized")
@@ -63,6 +65,17 @@ var _ = Describe("AiGatewayClass Webhook", func() {
 			Expect(warnings).To(BeNil())
 		})
 
+		It("Should warn that classes outside the class namespace are not used", func() {
+			obj.SetName("test-class-team")
+			obj.SetNamespace("team-a")
+			obj.Spec.Controller = testController
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(`AiGatewayClasses are only resolved in namespace "default"; ` +
+				"AiGateways cannot use this class"))
+		})
+
 		It("Should allow creation when this is the first default class", func() {
 			By("Creating a AiGatewayClass with default annotation")
 			obj.SetName("test-class-first-default")
@@ -104,6 +117,101 @@ var _ = Describe("AiGatewayClass Webhook", func() {
 			Expect(k8sClient.Delete(ctx, existingClass)).To(Succeed())
 		})
 
+		It("Should only warn about another default class if multiple defaults are allowed", func() {
+			By("Creating the first default class")
+			existingClass := &agenticlayeraiv1alpha1.AiGatewayClass{}
+			existingClass.SetName("existing-default-class")
+			existingClass.SetNamespace("default")
+			existingClass.Spec.Controller = testController
+			existingClass.SetAnnotations(map[string]string{
+				DefaultClassAnnotation: "true",
+			})
+			Expect(k8sClient.Create(ctx, existingClass)).To(Succeed())
+
+			By("Creating a second default class")
+			validator.AllowMultipleDefaults = true
+			obj.SetName("test-class-second-default")
+			obj.Spec.Controller = testController
+			obj.SetAnnotations(map[string]string{
+				DefaultClassAnnotation: "true",
+			})
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring(
+				`AiGatewayClass "existing-default-class" is marked as default as well and is older`)))
+		})
+
+		It("Should deny creation when the parametersRef is incomplete", func() {
+			By("Creating a AiGatewayClass with a parametersRef to a ConfigMap")
+			obj.SetName("test-class-parameters")
+			obj.Spec.Controller = testController
+			obj.Spec.ParametersRef = &agenticlayeraiv1alpha1.ParametersReference{
+				Kind: "ConfigMap",
+				Name: "litellm-parameters",
+			}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Omitting the kind")
+			obj.Spec.ParametersRef.Kind = ""
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.parametersRef.kind"))
+		})
+
+		It("Should deny creation when the allowed providers are empty or duplicated", func() {
+			By("Creating a AiGatewayClass restricting the providers")
+			obj.SetName("test-class-providers")
+			obj.Spec.Controller = testController
+			obj.Spec.AllowedProviders = []string{"openai", "azure"}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Listing a provider twice and an empty provider")
+			obj.Spec.AllowedProviders = []string{"openai", "", "openai"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.allowedProviders[1]: Required value"))
+			Expect(err.Error()).To(ContainSubstring(`spec.allowedProviders[2]: Duplicate value: "openai"`))
+		})
+
+		It("Should parse the templates of the templatesRef", func() {
+			By("Referencing a templates ConfigMap that does not exist yet")
+			obj.SetName("test-class-templates")
+			obj.SetNamespace("default")
+			obj.Spec.Controller = testController
+			obj.Spec.TemplatesRef = &agenticlayeraiv1alpha1.TemplatesReference{Name: "gateway-templates"}
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring(
+				`templates ConfigMap "gateway-templates" does not exist in namespace "default"`)))
+
+			By("Creating the ConfigMap with a template with a syntax error")
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "gateway-templates", Namespace: "default"},
+				Data:       map[string]string{agenticlayeraiv1alpha1.TemplateKeyService: "name: {{ .Name "},
+			}
+			Expect(k8sClient.Create(ctx, configMap)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, configMap)).To(Succeed()) })
+
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.templatesRef: Invalid value: "gateway-templates"`))
+			Expect(err.Error()).To(ContainSubstring(`invalid template "service.yaml"`))
+
+			By("Fixing the template")
+			configMap.Data[agenticlayeraiv1alpha1.TemplateKeyService] = "name: {{ .Name }}"
+			Expect(k8sClient.Update(ctx, configMap)).To(Succeed())
+
+			warnings, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
 		It("Should return error when validating wrong object type", func() {
 			By("Passing a wrong object type to ValidateCreate")
 			wrongObj := &agenticlayeraiv1alpha1.AiGateway{}
@@ -204,7 +312,7 @@ var _ = Describe("AiGatewayClass Webhook", func() {
 	})
 
 	Context("When deleting AiGatewayClass under Validating Webhook", func() {
-		It("Should always allow deletion", func() {
+		It("Should allow deletion of an unused class", func() {
 			By("Creating a AiGatewayClass")
 			obj.SetName("test-class-delete")
 			obj.Spec.Controller = testController
@@ -218,12 +326,67 @@ var _ = Describe("AiGatewayClass Webhook", func() {
 			Expect(warnings).To(BeNil())
 		})
 
-		It("Should allow deletion even for wrong object type", func() {
+		It("Should deny deletion of a class used by gateways", func() {
+			By("Creating an AiGateway of the class")
+			obj.SetName("test-class-in-use")
+			obj.Spec.Controller = testController
+			aiGateway := &agenticlayeraiv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "class-user", Namespace: "default"},
+				Spec: agenticlayeraiv1alpha1.AiGatewaySpec{
+					AiGatewayClassName: "test-class-in-use",
+					Port:               4000,
+					AiModels:           []agenticlayeraiv1alpha1.AiModel{{Name: "gpt-4", Provider: "openai"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, aiGateway)).To(Succeed())
+
+			By("Validating deletion")
+			_, err := validator.ValidateDelete(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				`AiGatewayClass "test-class-in-use" is still used by AiGateway default/class-user`))
+
+			By("Deleting the AiGateway")
+			Expect(k8sClient.Delete(ctx, aiGateway)).To(Succeed())
+			_, err = validator.ValidateDelete(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should only deny deletion of the class the gateways resolve to", func() {
+			By("Creating an AiGateway of the class")
+			aiGateway := &agenticlayeraiv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-name-user", Namespace: "default"},
+				Spec: agenticlayeraiv1alpha1.AiGatewaySpec{
+					AiGatewayClassName: "shared-name",
+					Port:               4000,
+					AiModels:           []agenticlayeraiv1alpha1.AiModel{{Name: "gpt-4", Provider: "openai"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, aiGateway)).To(Succeed())
+			DeferCleanup(func() { Expect(k8sClient.Delete(ctx, aiGateway)).To(Succeed()) })
+
+			By("Validating deletion of the class of the same name outside the class namespace")
+			obj.SetName("shared-name")
+			obj.SetNamespace("team-a")
+			obj.Spec.Controller = testController
+			_, err := validator.ValidateDelete(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Validating deletion of the class in the class namespace")
+			platformClass := obj.DeepCopy()
+			platformClass.SetNamespace("default")
+			_, err = validator.ValidateDelete(ctx, platformClass)
+			Expect(err).To(MatchError(ContainSubstring(
+				`AiGatewayClass "shared-name" is still used by AiGateway default/shared-name-user`)))
+		})
+
+		It("Should return error when validating wrong object type", func() {
 			By("Passing a wrong object type to ValidateDelete")
 			wrongObj := &agenticlayeraiv1alpha1.AiGateway{}
 
 			warnings, err := validator.ValidateDelete(ctx, wrongObj)
-			Expect(err).NotTo(HaveOccurred())
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("expected a AiGatewayClass object"))
 			Expect(warnings).To(BeNil())
 		})
 	})
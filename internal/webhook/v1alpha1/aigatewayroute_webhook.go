@@ -0,0 +1,188 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var aiGatewayRouteLog = logf.Log.WithName("aigatewayroute-resource")
+
+// SetupAiGatewayRouteWebhookWithManager registers the webhook for AiGatewayRoute in the manager.
+func SetupAiGatewayRouteWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiGatewayRoute{}).
+		WithValidator(&tracingValidator{kind: "AiGatewayRoute",
+			validator: &AiGatewayRouteCustomValidator{Client: tracingClient{mgr.GetClient()}}}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aigatewayroute,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aigatewayroutes,verbs=create;update,versions=v1alpha1,name=vaigatewayroute-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// AiGatewayRouteCustomValidator struct is responsible for validating the AiGatewayRoute resource
+// when it is created or updated.
+type AiGatewayRouteCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &AiGatewayRouteCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type AiGatewayRoute.
+func (v *AiGatewayRouteCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	route, ok := obj.(*gatewayv1alpha1.AiGatewayRoute)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiGatewayRoute object but got %T", obj)
+	}
+	requestLog(ctx, aiGatewayRouteLog, route).Info("Validation for AiGatewayRoute upon creation")
+
+	warnings, err := v.validateAiGatewayRoute(ctx, route)
+	recordValidation(ctx, "AiGatewayRoute", route, err)
+	return warnings, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiGatewayRoute.
+func (v *AiGatewayRouteCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	route, ok := newObj.(*gatewayv1alpha1.AiGatewayRoute)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiGatewayRoute object for the newObj but got %T", newObj)
+	}
+	requestLog(ctx, aiGatewayRouteLog, route).Info("Validation for AiGatewayRoute upon update")
+
+	warnings, err := v.validateAiGatewayRoute(ctx, route)
+	recordValidation(ctx, "AiGatewayRoute", route, err)
+	return warnings, err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiGatewayRoute.
+func (v *AiGatewayRouteCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// No validation needed on delete
+	return nil, nil
+}
+
+// validateAiGatewayRoute validates the rules of the route and checks its backend models against the parent AiGateway.
+// A missing parent only results in a warning, so routes can be created before their gateway.
+func (v *AiGatewayRouteCustomValidator) validateAiGatewayRoute(ctx context.Context,
+	route *gatewayv1alpha1.AiGatewayRoute) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	specPath := field.NewPath("spec")
+
+	var names []string
+	if route.Spec.ParentRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("parentRef", "name"), "name cannot be empty"))
+	} else {
+		var parent gatewayv1alpha1.AiGateway
+		key := types.NamespacedName{Namespace: route.Namespace, Name: route.Spec.ParentRef.Name}
+		switch err := v.Client.Get(ctx, key, &parent); {
+		case apierrors.IsNotFound(err):
+			warnings = append(warnings, fmt.Sprintf("parent AiGateway %q does not exist", key.Name))
+		case err != nil:
+			return nil, fmt.Errorf("failed to get AiGateway %s: %w", key, err)
+		default:
+			names = knownNames(&parent.Spec)
+		}
+	}
+
+	if len(route.Spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("rules"), "at least one rule is required"))
+	}
+	for i, rule := range route.Spec.Rules {
+		allErrs = append(allErrs, validateAiGatewayRouteRule(specPath.Child("rules").Index(i), rule, names)...)
+	}
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
+
+// validateAiGatewayRouteRule validates the path matches and backends of a route rule.
+// Backend models are only checked if the parent AiGateway lists its models.
+func validateAiGatewayRouteRule(fldPath *field.Path, rule gatewayv1alpha1.AiGatewayRouteRule,
+	names []string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(rule.Matches) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("matches"), "at least one match is required"))
+	}
+	for i, match := range rule.Matches {
+		if !strings.HasPrefix(match.Value, "/") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("matches").Index(i).Child("value"), match.Value,
+				"must start with '/'"))
+		}
+	}
+
+	if len(rule.BackendRefs) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("backendRefs"), "at least one backend is required"))
+	}
+	seen := make(map[string]bool)
+	var totalWeight int64
+	for i, backend := range rule.BackendRefs {
+		backendPath := fldPath.Child("backendRefs").Index(i)
+		weight := int32(1)
+		if backend.Weight != nil {
+			weight = *backend.Weight
+		}
+		if weight < 0 {
+			allErrs = append(allErrs, field.Invalid(backendPath.Child("weight"), weight, "must not be negative"))
+		}
+		totalWeight += int64(weight)
+
+		switch {
+		case backend.Model == "":
+			allErrs = append(allErrs, field.Required(backendPath.Child("model"), "model cannot be empty"))
+		case seen[backend.Model]:
+			allErrs = append(allErrs, field.Duplicate(backendPath.Child("model"), backend.Model))
+		case !hasModel(names, backend.Model):
+			allErrs = append(allErrs, field.NotFound(backendPath.Child("model"), backend.Model))
+		}
+		seen[backend.Model] = true
+	}
+	if len(rule.BackendRefs) > 0 && totalWeight == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("backendRefs"), totalWeight,
+			"at least one backend must have a positive weight"))
+	}
+
+	if rule.Mirror != nil {
+		allErrs = append(allErrs, validateMirrorConfig(fldPath.Child("mirror"), rule.Mirror, "", names)...)
+		if seen[rule.Mirror.Model] {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("mirror", "model"), rule.Mirror.Model,
+				"the shadow model must not be a backend of the rule"))
+		}
+	}
+
+	if rule.PromptPolicy != nil {
+		allErrs = append(allErrs, validatePromptPolicy(fldPath.Child("promptPolicy"), rule.PromptPolicy)...)
+	}
+
+	return allErrs
+}
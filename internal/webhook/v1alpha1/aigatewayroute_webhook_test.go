@@ -0,0 +1,127 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiGatewayRoute Webhook", func() {
+	var (
+		obj       *gatewayv1alpha1.AiGatewayRoute
+		validator AiGatewayRouteCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.AiGatewayRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-route", Namespace: "default"},
+			Spec: gatewayv1alpha1.AiGatewayRouteSpec{
+				ParentRef: corev1.LocalObjectReference{Name: "route-parent"},
+				Rules: []gatewayv1alpha1.AiGatewayRouteRule{{
+					Matches: []gatewayv1alpha1.PathMatch{
+						{Type: gatewayv1alpha1.PathMatchPathPrefix, Value: "/team-a"},
+					},
+					BackendRefs: []gatewayv1alpha1.ModelBackendRef{
+						{Model: "gpt-4o", Weight: ptr.To[int32](90)},
+						{Model: "claude", Weight: ptr.To[int32](10)},
+					},
+				}},
+			},
+		}
+		validator = AiGatewayRouteCustomValidator{Client: k8sClient}
+	})
+
+	AfterEach(func() {
+		var gateways gatewayv1alpha1.AiGatewayList
+		_ = k8sClient.List(ctx, &gateways)
+		for _, gateway := range gateways.Items {
+			_ = k8sClient.Delete(ctx, &gateway)
+		}
+	})
+
+	Context("When creating or updating AiGatewayRoute under Validating Webhook", func() {
+		It("Should warn if the parent AiGateway does not exist", func() {
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(`parent AiGateway "route-parent" does not exist`))
+		})
+
+		It("Should deny backends that are not models of the parent AiGateway", func() {
+			parent := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "route-parent", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port: 4000,
+					AiModels: []gatewayv1alpha1.AiModel{
+						{Name: "gpt-4o", Provider: "openai"},
+						{Name: "claude-3-5-sonnet", Provider: "anthropic", Alias: "claude"},
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, parent)).To(Succeed())
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			obj.Spec.Rules[0].BackendRefs[1].Model = "mistral"
+			_, err = validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.rules[0].backendRefs[1].model: Not found: "mistral"`))
+		})
+
+		It("Should deny invalid paths and rules without weighted backends", func() {
+			obj.Spec.Rules[0].Matches[0].Value = "team-a"
+			obj.Spec.Rules[0].BackendRefs[0].Weight = ptr.To[int32](0)
+			obj.Spec.Rules[0].BackendRefs[1].Weight = ptr.To[int32](0)
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.rules[0].matches[0].value"))
+			Expect(err.Error()).To(ContainSubstring("at least one backend must have a positive weight"))
+		})
+
+		It("Should deny mirroring to a backend of the rule", func() {
+			obj.Spec.Rules[0].Mirror = &gatewayv1alpha1.MirrorConfig{Model: "claude"}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("the shadow model must not be a backend of the rule"))
+		})
+
+		It("Should validate the prompt policy of a rule", func() {
+			obj.Spec.Rules[0].PromptPolicy = &gatewayv1alpha1.PromptPolicy{
+				SystemPrefix: &gatewayv1alpha1.SystemPromptInjection{
+					TemplateRef: &gatewayv1alpha1.PromptTemplateRef{Name: "support-agent"},
+					Placement:   gatewayv1alpha1.PromptPlacementReplace,
+				},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Rules[0].PromptPolicy.SystemPrefix.TemplateRef = nil
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.rules[0].promptPolicy.systemPrefix: Required value"))
+		})
+	})
+})
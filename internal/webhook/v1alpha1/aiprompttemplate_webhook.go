@@ -0,0 +1,122 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var aiPromptTemplateLog = logf.Log.WithName("aiprompttemplate-resource")
+
+// SetupAiPromptTemplateWebhookWithManager registers the webhook for AiPromptTemplate in the manager.
+func SetupAiPromptTemplateWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiPromptTemplate{}).
+		WithValidator(&tracingValidator{kind: "AiPromptTemplate", validator: &AiPromptTemplateCustomValidator{}}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aiprompttemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aiprompttemplates,verbs=create;update,versions=v1alpha1,name=vaiprompttemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// AiPromptTemplateCustomValidator struct is responsible for validating the AiPromptTemplate resource
+// when it is created or updated.
+type AiPromptTemplateCustomValidator struct{}
+
+var _ webhook.CustomValidator = &AiPromptTemplateCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type AiPromptTemplate.
+func (v *AiPromptTemplateCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	tmpl, ok := obj.(*gatewayv1alpha1.AiPromptTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiPromptTemplate object but got %T", obj)
+	}
+	requestLog(ctx, aiPromptTemplateLog, tmpl).Info("Validation for AiPromptTemplate upon creation")
+
+	warnings, err := validateAiPromptTemplate(tmpl)
+	recordValidation(ctx, "AiPromptTemplate", tmpl, err)
+	return warnings, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiPromptTemplate.
+func (v *AiPromptTemplateCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	tmpl, ok := newObj.(*gatewayv1alpha1.AiPromptTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiPromptTemplate object for the newObj but got %T", newObj)
+	}
+	requestLog(ctx, aiPromptTemplateLog, tmpl).Info("Validation for AiPromptTemplate upon update")
+
+	warnings, err := validateAiPromptTemplate(tmpl)
+	recordValidation(ctx, "AiPromptTemplate", tmpl, err)
+	return warnings, err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiPromptTemplate.
+func (v *AiPromptTemplateCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// No validation needed on delete
+	return nil, nil
+}
+
+// validateAiPromptTemplate ensures every placeholder of the template is declared as a variable exactly once.
+// Declared variables the template does not use only result in a warning.
+func validateAiPromptTemplate(tmpl *gatewayv1alpha1.AiPromptTemplate) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	specPath := field.NewPath("spec")
+
+	if ref := tmpl.Spec.GatewayRef; ref != nil && ref.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("gatewayRef", "name"), "name cannot be empty"))
+	}
+	if tmpl.Spec.Template == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("template"), "template cannot be empty"))
+	}
+
+	placeholders := tmpl.Placeholders()
+	var declared []string
+	for i, variable := range tmpl.Spec.Variables {
+		namePath := specPath.Child("variables").Index(i).Child("name")
+		switch {
+		case slices.Contains(declared, variable.Name):
+			allErrs = append(allErrs, field.Duplicate(namePath, variable.Name))
+		case !slices.Contains(placeholders, variable.Name):
+			warnings = append(warnings, fmt.Sprintf("variable %q is not used by the template", variable.Name))
+		}
+		declared = append(declared, variable.Name)
+	}
+	for _, name := range placeholders {
+		if !slices.Contains(declared, name) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("template"), "{{ "+name+" }}",
+				"variable is not declared in spec.variables"))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiPromptTemplate Webhook", func() {
+	var (
+		obj       *gatewayv1alpha1.AiPromptTemplate
+		validator AiPromptTemplateCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.AiPromptTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "support-agent", Namespace: "default"},
+			Spec: gatewayv1alpha1.AiPromptTemplateSpec{
+				Template: "You are a support agent of {{ company }}. Answer in {{ language }}.",
+				Variables: []gatewayv1alpha1.PromptVariable{
+					{Name: "company"},
+					{Name: "language", Default: ptr.To("English")},
+				},
+			},
+		}
+		validator = AiPromptTemplateCustomValidator{}
+	})
+
+	Context("When creating or updating AiPromptTemplate under Validating Webhook", func() {
+		It("Should admit a template declaring its variables", func() {
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+
+		It("Should deny undeclared placeholders and warn about unused variables", func() {
+			obj.Spec.Template = "You are a support agent of {{ company }} for {{ product }}."
+
+			warnings, err := validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(
+				`spec.template: Invalid value: "{{ product }}": variable is not declared in spec.variables`))
+			Expect(warnings).To(ConsistOf(`variable "language" is not used by the template`))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var aiRateLimitPolicyLog = logf.Log.WithName("airatelimitpolicy-resource")
+
+// SetupAiRateLimitPolicyWebhookWithManager registers the webhook for AiRateLimitPolicy in the manager.
+func SetupAiRateLimitPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiRateLimitPolicy{}).
+		WithValidator(&tracingValidator{kind: "AiRateLimitPolicy", validator: &AiRateLimitPolicyCustomValidator{}}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-airatelimitpolicy,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=airatelimitpolicies,verbs=create;update,versions=v1alpha1,name=vairatelimitpolicy-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// AiRateLimitPolicyCustomValidator struct is responsible for validating the AiRateLimitPolicy resource
+// when it is created or updated.
+type AiRateLimitPolicyCustomValidator struct{}
+
+var _ webhook.CustomValidator = &AiRateLimitPolicyCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type AiRateLimitPolicy.
+func (v *AiRateLimitPolicyCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	policy, ok := obj.(*gatewayv1alpha1.AiRateLimitPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiRateLimitPolicy object but got %T", obj)
+	}
+	requestLog(ctx, aiRateLimitPolicyLog, policy).Info("Validation for AiRateLimitPolicy upon creation")
+
+	err := validateAiRateLimitPolicy(policy)
+	recordValidation(ctx, "AiRateLimitPolicy", policy, err)
+	return nil, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiRateLimitPolicy.
+func (v *AiRateLimitPolicyCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	policy, ok := newObj.(*gatewayv1alpha1.AiRateLimitPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiRateLimitPolicy object for the newObj but got %T", newObj)
+	}
+	requestLog(ctx, aiRateLimitPolicyLog, policy).Info("Validation for AiRateLimitPolicy upon update")
+
+	err := validateAiRateLimitPolicy(policy)
+	recordValidation(ctx, "AiRateLimitPolicy", policy, err)
+	return nil, err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiRateLimitPolicy.
+func (v *AiRateLimitPolicyCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// No validation needed on delete
+	return nil, nil
+}
+
+// validateAiRateLimitPolicy ensures the policy selects exactly one kind of subject and sets at least one limit,
+// a budget or request limits.
+func validateAiRateLimitPolicy(policy *gatewayv1alpha1.AiRateLimitPolicy) error {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if ref := policy.Spec.GatewayRef; ref != nil && ref.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("gatewayRef", "name"), "name cannot be empty"))
+	}
+
+	subject := policy.Spec.Subject
+	subjectPath := specPath.Child("subject")
+	var set []string
+	if subject.VirtualKey != "" {
+		set = append(set, "virtualKey")
+	}
+	if subject.Team != "" {
+		set = append(set, "team")
+	}
+	if subject.NamespaceSelector != nil {
+		set = append(set, "namespaceSelector")
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(subject.NamespaceSelector,
+			metav1validation.LabelSelectorValidationOptions{}, subjectPath.Child("namespaceSelector"))...)
+	}
+	switch {
+	case len(set) == 0:
+		allErrs = append(allErrs, field.Required(subjectPath,
+			"one of virtualKey, team or namespaceSelector must be set"))
+	case len(set) > 1:
+		allErrs = append(allErrs, field.Invalid(subjectPath, set,
+			"only one of virtualKey, team or namespaceSelector may be set"))
+	}
+
+	if (policy.Spec.Budget == nil && policy.Spec.RequestLimits == nil) ||
+		policy.Spec.Limits != (gatewayv1alpha1.RateLimits{}) {
+		allErrs = append(allErrs, validateRateLimits(specPath.Child("limits"), policy.Spec.Limits)...)
+	}
+	if policy.Spec.Budget != nil {
+		allErrs = append(allErrs, validateSpendBudget(specPath.Child("budget"), policy.Spec.Budget)...)
+	}
+	if policy.Spec.RequestLimits != nil {
+		allErrs = append(allErrs, validateRequestTokenLimits(specPath.Child("requestLimits"),
+			policy.Spec.RequestLimits)...)
+	}
+
+	if len(allErrs) > 0 {
+		return allErrs.ToAggregate()
+	}
+	return nil
+}
+
+// validateRateLimits validates that at least one limit is set and all limits are positive.
+func validateRateLimits(fldPath *field.Path, limits gatewayv1alpha1.RateLimits) field.ErrorList {
+	var allErrs field.ErrorList
+	if limits.RequestsPerMinute == nil && limits.TokensPerMinute == nil && limits.MaxParallelRequests == nil {
+		allErrs = append(allErrs, field.Required(fldPath,
+			"one of requestsPerMinute, tokensPerMinute or maxParallelRequests must be set"))
+	}
+	if limit := limits.RequestsPerMinute; limit != nil && *limit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("requestsPerMinute"), *limit, "must be at least 1"))
+	}
+	if limit := limits.TokensPerMinute; limit != nil && *limit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tokensPerMinute"), *limit, "must be at least 1"))
+	}
+	if limit := limits.MaxParallelRequests; limit != nil && *limit < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxParallelRequests"), *limit, "must be at least 1"))
+	}
+	return allErrs
+}
+
+// validateSpendBudget validates that the maximum spend and the window of a budget are positive.
+func validateSpendBudget(fldPath *field.Path, budget *gatewayv1alpha1.SpendBudget) field.ErrorList {
+	var allErrs field.ErrorList
+	if spend, err := strconv.ParseFloat(budget.MaxSpendUSD, 64); err != nil || spend <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSpendUSD"), budget.MaxSpendUSD,
+			"must be a positive decimal number"))
+	}
+	if budget.Window.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("window"), budget.Window.Duration.String(),
+			"must be positive"))
+	}
+	return allErrs
+}
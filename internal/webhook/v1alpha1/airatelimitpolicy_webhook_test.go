@@ -0,0 +1,96 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiRateLimitPolicy Webhook", func() {
+	var (
+		obj       *gatewayv1alpha1.AiRateLimitPolicy
+		validator AiRateLimitPolicyCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.AiRateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-policy", Namespace: "default"},
+		}
+		validator = AiRateLimitPolicyCustomValidator{}
+	})
+
+	Context("When creating or updating AiRateLimitPolicy under Validating Webhook", func() {
+		It("Should admit a policy with one subject and a limit", func() {
+			obj.Spec.Subject.Team = "research"
+			obj.Spec.Limits.TokensPerMinute = ptr.To[int64](100000)
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a policy without a subject or limits", func() {
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.subject: Required value"))
+			Expect(err.Error()).To(ContainSubstring("spec.limits: Required value"))
+		})
+
+		It("Should validate spend budgets", func() {
+			obj.Spec.Subject.VirtualKey = "ci"
+			obj.Spec.Budget = &gatewayv1alpha1.SpendBudget{
+				MaxSpendUSD: "50",
+				Window:      metav1.Duration{Duration: 24 * time.Hour},
+			}
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+
+			obj.Spec.Budget = &gatewayv1alpha1.SpendBudget{MaxSpendUSD: "0"}
+			_, err = validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.budget.maxSpendUSD: Invalid value"))
+			Expect(err.Error()).To(ContainSubstring("spec.budget.window: Invalid value"))
+			Expect(err.Error()).NotTo(ContainSubstring("spec.limits"))
+		})
+
+		It("Should admit a policy with only request limits", func() {
+			obj.Spec.Subject.Team = "research"
+			obj.Spec.RequestLimits = &gatewayv1alpha1.RequestTokenLimits{MaxOutputTokens: ptr.To[int32](2048)}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should deny a policy with several subjects", func() {
+			obj.Spec.Subject.VirtualKey = "ci"
+			obj.Spec.Subject.NamespaceSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{"tier": "free"},
+			}
+			obj.Spec.Limits.RequestsPerMinute = ptr.To[int32](60)
+
+			_, err := validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only one of virtualKey, team or namespaceSelector may be set"))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var aiTeamLog = logf.Log.WithName("aiteam-resource")
+
+// SetupAiTeamWebhookWithManager registers the webhook for AiTeam in the manager.
+func SetupAiTeamWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiTeam{}).
+		WithValidator(&tracingValidator{kind: "AiTeam",
+			validator: &AiTeamCustomValidator{Client: tracingClient{mgr.GetClient()}}}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aiteam,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aiteams,verbs=create;update,versions=v1alpha1,name=vaiteam-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// AiTeamCustomValidator struct is responsible for validating the AiTeam resource
+// when it is created or updated.
+type AiTeamCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &AiTeamCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type AiTeam.
+func (v *AiTeamCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	team, ok := obj.(*gatewayv1alpha1.AiTeam)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiTeam object but got %T", obj)
+	}
+	requestLog(ctx, aiTeamLog, team).Info("Validation for AiTeam upon creation")
+
+	warnings, err := v.validateAiTeam(ctx, team)
+	recordValidation(ctx, "AiTeam", team, err)
+	return warnings, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiTeam.
+func (v *AiTeamCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	team, ok := newObj.(*gatewayv1alpha1.AiTeam)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiTeam object for the newObj but got %T", newObj)
+	}
+	requestLog(ctx, aiTeamLog, team).Info("Validation for AiTeam upon update")
+
+	warnings, err := v.validateAiTeam(ctx, team)
+	recordValidation(ctx, "AiTeam", team, err)
+	return warnings, err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiTeam.
+func (v *AiTeamCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// No validation needed on delete
+	return nil, nil
+}
+
+// validateAiTeam validates the members and budget of the team and checks its models against the referenced
+// AiGateway. A missing gateway only results in a warning, so teams can be created before their gateway.
+func (v *AiTeamCustomValidator) validateAiTeam(ctx context.Context,
+	team *gatewayv1alpha1.AiTeam) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	specPath := field.NewPath("spec")
+
+	var names []string
+	if ref := team.Spec.GatewayRef; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("gatewayRef", "name"), "name cannot be empty"))
+		} else {
+			var warning string
+			var err error
+			if names, warning, err = gatewayModelNames(ctx, v.Client, team.Namespace, ref.Name); err != nil {
+				return nil, err
+			} else if warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+	}
+
+	users := make(map[string]bool)
+	for i, member := range team.Spec.Members {
+		userPath := specPath.Child("members").Index(i).Child("userID")
+		switch {
+		case member.UserID == "":
+			allErrs = append(allErrs, field.Required(userPath, "user ID cannot be empty"))
+		case users[member.UserID]:
+			allErrs = append(allErrs, field.Duplicate(userPath, member.UserID))
+		}
+		users[member.UserID] = true
+	}
+
+	allErrs = append(allErrs, validateModelNames(specPath.Child("models"), team.Spec.Models, names)...)
+
+	if team.Spec.Budget != nil {
+		allErrs = append(allErrs, validateSpendBudget(specPath.Child("budget"), team.Spec.Budget)...)
+	}
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
+
+// gatewayModelNames returns the public model names of an AiGateway as returned by knownNames, or a warning if
+// the AiGateway does not exist.
+func gatewayModelNames(ctx context.Context, c client.Client, namespace, name string) ([]string, string, error) {
+	var gateway gatewayv1alpha1.AiGateway
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	switch err := c.Get(ctx, key, &gateway); {
+	case apierrors.IsNotFound(err):
+		return nil, fmt.Sprintf("AiGateway %q does not exist", key.Name), nil
+	case err != nil:
+		return nil, "", fmt.Errorf("failed to get AiGateway %s: %w", key, err)
+	}
+	return knownNames(&gateway.Spec), "", nil
+}
+
+// validateModelNames ensures a list of public model names has no empty or duplicate entries and only names
+// models of the gateway.
+func validateModelNames(fldPath *field.Path, models, names []string) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := make(map[string]bool)
+	for i, model := range models {
+		switch {
+		case model == "":
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "model cannot be empty"))
+		case seen[model]:
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), model))
+		case !hasModel(names, model):
+			allErrs = append(allErrs, field.NotFound(fldPath.Index(i), model))
+		}
+		seen[model] = true
+	}
+	return allErrs
+}
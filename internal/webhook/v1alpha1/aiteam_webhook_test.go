@@ -0,0 +1,95 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiTeam Webhook", func() {
+	var (
+		obj       *gatewayv1alpha1.AiTeam
+		validator AiTeamCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.AiTeam{
+			ObjectMeta: metav1.ObjectMeta{Name: "research", Namespace: "default"},
+			Spec: gatewayv1alpha1.AiTeamSpec{
+				GatewayRef: &corev1.LocalObjectReference{Name: "team-gateway"},
+				Members: []gatewayv1alpha1.TeamMember{
+					{UserID: "alice", Role: gatewayv1alpha1.TeamRoleAdmin},
+					{UserID: "bob", Role: gatewayv1alpha1.TeamRoleUser},
+				},
+				Models: []string{"gpt-4o"},
+			},
+		}
+		validator = AiTeamCustomValidator{Client: k8sClient}
+	})
+
+	AfterEach(func() {
+		var gateways gatewayv1alpha1.AiGatewayList
+		_ = k8sClient.List(ctx, &gateways)
+		for _, gateway := range gateways.Items {
+			_ = k8sClient.Delete(ctx, &gateway)
+		}
+	})
+
+	Context("When creating or updating AiTeam under Validating Webhook", func() {
+		It("Should warn if the AiGateway does not exist", func() {
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ContainElement(`AiGateway "team-gateway" does not exist`))
+		})
+
+		It("Should deny models that the AiGateway does not serve", func() {
+			gateway := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-gateway", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port:     4000,
+					AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, gateway)).To(Succeed())
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			obj.Spec.Models = append(obj.Spec.Models, "claude")
+			_, err = validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.models[1]: Not found: "claude"`))
+		})
+
+		It("Should deny duplicate members and invalid budgets", func() {
+			obj.Spec.GatewayRef = nil
+			obj.Spec.Members = append(obj.Spec.Members, gatewayv1alpha1.TeamMember{UserID: "alice"})
+			obj.Spec.Budget = &gatewayv1alpha1.SpendBudget{MaxSpendUSD: "0"}
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.members[2].userID: Duplicate value: "alice"`))
+			Expect(err.Error()).To(ContainSubstring("spec.budget.maxSpendUSD: Invalid value"))
+		})
+	})
+})
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var aiVirtualKeyLog = logf.Log.WithName("aivirtualkey-resource")
+
+// SetupAiVirtualKeyWebhookWithManager registers the webhook for AiVirtualKey in the manager.
+func SetupAiVirtualKeyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.AiVirtualKey{}).
+		WithValidator(&tracingValidator{kind: "AiVirtualKey",
+			validator: &AiVirtualKeyCustomValidator{Client: tracingClient{mgr.GetClient()}}}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-aivirtualkey,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=aivirtualkeys,verbs=create;update,versions=v1alpha1,name=vaivirtualkey-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// AiVirtualKeyCustomValidator struct is responsible for validating the AiVirtualKey resource
+// when it is created or updated.
+type AiVirtualKeyCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &AiVirtualKeyCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type AiVirtualKey.
+func (v *AiVirtualKeyCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	key, ok := obj.(*gatewayv1alpha1.AiVirtualKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiVirtualKey object but got %T", obj)
+	}
+	requestLog(ctx, aiVirtualKeyLog, key).Info("Validation for AiVirtualKey upon creation")
+
+	warnings, err := v.validateAiVirtualKey(ctx, key)
+	recordValidation(ctx, "AiVirtualKey", key, err)
+	return warnings, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type AiVirtualKey.
+func (v *AiVirtualKeyCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	key, ok := newObj.(*gatewayv1alpha1.AiVirtualKey)
+	if !ok {
+		return nil, fmt.Errorf("expected a AiVirtualKey object for the newObj but got %T", newObj)
+	}
+	requestLog(ctx, aiVirtualKeyLog, key).Info("Validation for AiVirtualKey upon update")
+
+	warnings, err := v.validateAiVirtualKey(ctx, key)
+	recordValidation(ctx, "AiVirtualKey", key, err)
+	return warnings, err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type AiVirtualKey.
+func (v *AiVirtualKeyCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// No validation needed on delete
+	return nil, nil
+}
+
+// validateAiVirtualKey validates the rotation settings of the key and checks its models against the referenced
+// AiGateway. A missing gateway, ServiceAccount or AiTeam only results in a warning, so keys can be created
+// before them.
+func (v *AiVirtualKeyCustomValidator) validateAiVirtualKey(ctx context.Context,
+	key *gatewayv1alpha1.AiVirtualKey) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	var warnings admission.Warnings
+	specPath := field.NewPath("spec")
+
+	var names []string
+	if key.Spec.GatewayRef.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("gatewayRef", "name"), "name cannot be empty"))
+	} else {
+		var warning string
+		var err error
+		if names, warning, err = gatewayModelNames(ctx, v.Client, key.Namespace, key.Spec.GatewayRef.Name); err != nil {
+			return nil, err
+		} else if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	allErrs = append(allErrs, validateModelNames(specPath.Child("models"), key.Spec.Models, names)...)
+
+	for _, ref := range []struct {
+		kind string
+		name string
+		obj  client.Object
+	}{
+		{"ServiceAccount", key.Spec.ServiceAccountName, &corev1.ServiceAccount{}},
+		{"AiTeam", teamName(key.Spec.TeamRef), &gatewayv1alpha1.AiTeam{}},
+	} {
+		if ref.name == "" {
+			continue
+		}
+		objKey := types.NamespacedName{Namespace: key.Namespace, Name: ref.name}
+		switch err := v.Client.Get(ctx, objKey, ref.obj); {
+		case apierrors.IsNotFound(err):
+			warnings = append(warnings, fmt.Sprintf("%s %q does not exist", ref.kind, ref.name))
+		case err != nil:
+			return nil, fmt.Errorf("failed to get %s %s: %w", ref.kind, objKey, err)
+		}
+	}
+	if key.Spec.ServiceAccountName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("serviceAccountName"),
+			"service account name cannot be empty"))
+	}
+
+	if key.Spec.SecretName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(key.Spec.SecretName) {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("secretName"), key.Spec.SecretName, msg))
+		}
+	}
+	allErrs = append(allErrs, validateKeyRotation(specPath, &key.Spec)...)
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
+
+// teamName returns the name of a referenced AiTeam, or an empty string if none is referenced.
+func teamName(ref *corev1.LocalObjectReference) string {
+	if ref == nil {
+		return ""
+	}
+	return ref.Name
+}
+
+// validateKeyRotation ensures the rotation interval is positive and the grace period is shorter than it, so that
+// at most two keys are valid at any time.
+func validateKeyRotation(fldPath *field.Path, spec *gatewayv1alpha1.AiVirtualKeySpec) field.ErrorList {
+	var allErrs field.ErrorList
+
+	interval := gatewayv1alpha1.DefaultKeyRotationInterval
+	if spec.RotationInterval != nil {
+		interval = spec.RotationInterval.Duration
+		if interval <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rotationInterval"), interval.String(),
+				"must be positive"))
+		}
+	}
+	if grace := spec.GracePeriod; grace != nil {
+		if grace.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gracePeriod"), grace.Duration.String(),
+				"must not be negative"))
+		} else if interval > 0 && grace.Duration >= interval {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("gracePeriod"), grace.Duration.String(),
+				"must be shorter than the rotation interval"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("AiVirtualKey Webhook", func() {
+	var (
+		obj       *gatewayv1alpha1.AiVirtualKey
+		validator AiVirtualKeyCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.AiVirtualKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "batch-jobs", Namespace: "default"},
+			Spec: gatewayv1alpha1.AiVirtualKeySpec{
+				GatewayRef:         corev1.LocalObjectReference{Name: "key-gateway"},
+				ServiceAccountName: "batch",
+				Models:             []string{"gpt-4o"},
+			},
+		}
+		validator = AiVirtualKeyCustomValidator{Client: k8sClient}
+	})
+
+	AfterEach(func() {
+		var gateways gatewayv1alpha1.AiGatewayList
+		_ = k8sClient.List(ctx, &gateways)
+		for _, gateway := range gateways.Items {
+			_ = k8sClient.Delete(ctx, &gateway)
+		}
+		_ = k8sClient.Delete(ctx, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: "default"}})
+	})
+
+	Context("When creating or updating AiVirtualKey under Validating Webhook", func() {
+		It("Should warn if the AiGateway, ServiceAccount or AiTeam does not exist", func() {
+			obj.Spec.TeamRef = &corev1.LocalObjectReference{Name: "research"}
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				`AiGateway "key-gateway" does not exist`,
+				`ServiceAccount "batch" does not exist`,
+				`AiTeam "research" does not exist`,
+			))
+		})
+
+		It("Should deny models that the AiGateway does not serve", func() {
+			gateway := &gatewayv1alpha1.AiGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "key-gateway", Namespace: "default"},
+				Spec: gatewayv1alpha1.AiGatewaySpec{
+					Port:     4000,
+					AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				},
+			}
+			Expect(k8sClient.Create(ctx, gateway)).To(Succeed())
+			serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "batch", Namespace: "default"}}
+			Expect(k8sClient.Create(ctx, serviceAccount)).To(Succeed())
+
+			warnings, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+
+			obj.Spec.Models = []string{"gpt-4o", "gpt-4o"}
+			_, err = validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`spec.models[1]: Duplicate value: "gpt-4o"`))
+		})
+
+		It("Should deny a grace period not shorter than the rotation interval", func() {
+			obj.Spec.RotationInterval = &metav1.Duration{Duration: time.Hour}
+			obj.Spec.GracePeriod = &metav1.Duration{Duration: 2 * time.Hour}
+			obj.Spec.SecretName = "Invalid_Name"
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("spec.gracePeriod: Invalid value: \"2h0m0s\": must be shorter than the rotation interval"))
+			Expect(err.Error()).To(ContainSubstring("spec.secretName: Invalid value"))
+		})
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// nolint:unused
+// log is for logging in this package.
+var clusterAiGatewayLog = logf.Log.WithName("clusteraigateway-resource")
+
+// SetupClusterAiGatewayWebhookWithManager registers the webhook for ClusterAiGateway in the manager.
+func SetupClusterAiGatewayWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&gatewayv1alpha1.ClusterAiGateway{}).
+		WithValidator(&tracingValidator{kind: "ClusterAiGateway",
+			validator: &ClusterAiGatewayCustomValidator{Client: tracingClient{mgr.GetClient()}}}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-agentic-layer-ai-v1alpha1-clusteraigateway,mutating=false,failurePolicy=fail,sideEffects=None,groups=agentic-layer.ai,resources=clusteraigateways,verbs=create;update,versions=v1alpha1,name=vclusteraigateway-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ClusterAiGatewayCustomValidator struct is responsible for validating the ClusterAiGateway resource
+// when it is created or updated.
+type ClusterAiGatewayCustomValidator struct {
+	Client client.Client
+}
+
+var _ webhook.CustomValidator = &ClusterAiGatewayCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type ClusterAiGateway.
+func (v *ClusterAiGatewayCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	gateway, ok := obj.(*gatewayv1alpha1.ClusterAiGateway)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterAiGateway object but got %T", obj)
+	}
+	requestLog(ctx, clusterAiGatewayLog, gateway).Info("Validation for ClusterAiGateway upon creation")
+
+	warnings, err := v.validateClusterAiGateway(ctx, gateway)
+	recordValidation(ctx, "ClusterAiGateway", gateway, err)
+	return warnings, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type ClusterAiGateway.
+func (v *ClusterAiGatewayCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	gateway, ok := newObj.(*gatewayv1alpha1.ClusterAiGateway)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterAiGateway object for the newObj but got %T", newObj)
+	}
+	requestLog(ctx, clusterAiGatewayLog, gateway).Info("Validation for ClusterAiGateway upon update")
+
+	warnings, err := v.validateClusterAiGateway(ctx, gateway)
+	recordValidation(ctx, "ClusterAiGateway", gateway, err)
+	return warnings, err
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type ClusterAiGateway.
+func (v *ClusterAiGatewayCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	// No validation needed on delete
+	return nil, nil
+}
+
+// validateClusterAiGateway validates the gateway spec like the spec of an AiGateway and checks its exposure.
+// AiModelPolicies and AiGatewayQuotas are namespace-scoped and do not apply to ClusterAiGateways.
+func (v *ClusterAiGatewayCustomValidator) validateClusterAiGateway(ctx context.Context,
+	gateway *gatewayv1alpha1.ClusterAiGateway) (admission.Warnings, error) {
+	aiGateway := gateway.AsAiGateway("")
+	warnings, allErrs := validateAiGatewaySpec(aiGateway)
+	specPath := field.NewPath("spec")
+
+	if gateway.Spec.Discovery != nil {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("discovery"),
+			"use spec.namespaceSelector to expose a ClusterAiGateway"))
+	}
+	if gateway.Spec.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(gateway.Spec.NamespaceSelector,
+			metav1validation.LabelSelectorValidationOptions{}, specPath.Child("namespaceSelector"))...)
+	}
+
+	validator := &AiGatewayCustomValidator{Client: v.Client}
+	for _, validate := range []func(context.Context, *gatewayv1alpha1.AiGateway) (field.ErrorList, error){
+		validator.validateModelLibraries,
+		validator.validateAllowedProviders,
+		validator.validateMaxModels,
+	} {
+		errs, err := validate(ctx, aiGateway)
+		if err != nil {
+			return warnings, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	if len(allErrs) > 0 {
+		return warnings, allErrs.ToAggregate()
+	}
+	return warnings, nil
+}
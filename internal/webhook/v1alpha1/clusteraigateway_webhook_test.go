@@ -0,0 +1,72 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("ClusterAiGateway Webhook", func() {
+	var (
+		obj       *gatewayv1alpha1.ClusterAiGateway
+		validator ClusterAiGatewayCustomValidator
+	)
+
+	BeforeEach(func() {
+		obj = &gatewayv1alpha1.ClusterAiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+			Spec: gatewayv1alpha1.ClusterAiGatewaySpec{
+				AiGatewaySpec: gatewayv1alpha1.AiGatewaySpec{
+					Port:     4000,
+					AiModels: []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				},
+				Namespace: "ai-system",
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"agentic-layer.ai/gateway": "platform"},
+				},
+			},
+		}
+		validator = ClusterAiGatewayCustomValidator{Client: k8sClient}
+	})
+
+	Context("When creating or updating ClusterAiGateway under Validating Webhook", func() {
+		It("Should admit a valid shared gateway", func() {
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Should validate the gateway spec like an AiGateway", func() {
+			obj.Spec.AiModels = nil
+
+			_, err := validator.ValidateCreate(ctx, obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no AI models specified in AiGateway"))
+		})
+
+		It("Should deny spec.discovery", func() {
+			obj.Spec.Discovery = &gatewayv1alpha1.DiscoveryConfig{}
+
+			_, err := validator.ValidateUpdate(ctx, obj.DeepCopy(), obj)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("use spec.namespaceSelector to expose a ClusterAiGateway"))
+		})
+	})
+})
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+	"github.com/agentic-layer/ai-gateway-operator/internal/providers"
+)
+
+// credentialCheckTimeout bounds the time spent checking the API keys of all models of an AiGateway, well within
+// the timeout of the admission webhook.
+const credentialCheckTimeout = 3 * time.Second
+
+// credentialCheck identifies the API key checks of the models of a gateway, so every key is checked once per
+// provider.
+type credentialCheck struct {
+	provider string
+	ref      corev1.SecretKeySelector
+}
+
+// verifyCredentials lists the models of the provider of every model with an apiKeySecretRef, using the referenced
+// API key, and warns about the keys the provider rejects, so invalid keys show up on apply rather than as failing
+// requests. Keys that cannot be read and providers that cannot be reached are only logged, as they don't prove the
+// key invalid; missing Secrets are reported by credentialWarnings.
+//
+// Keys are only sent to the fixed public API of the provider, never to the apiBase of a model: the webhook reads
+// the Secret with the permissions of the operator, so anyone allowed to create an AiGateway could otherwise
+// receive any Secret of the namespace on a server of their own, or make the operator call arbitrary URLs.
+func (v *AiGatewayCustomValidator) verifyCredentials(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, error) {
+	models, err := v.gatewayModels(ctx, aiGateway)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, credentialCheckTimeout)
+	defer cancel()
+
+	var warnings admission.Warnings
+	checked := make(map[credentialCheck]bool)
+	for _, model := range models {
+		ref := model.APIKeySecretRef
+		if _, supported := v.newModelLister(model.Provider, ""); ref == nil || model.APIBase != "" || !supported {
+			continue
+		}
+		check := credentialCheck{provider: model.Provider, ref: *ref}
+		if checked[check] {
+			continue
+		}
+		checked[check] = true
+
+		var secret corev1.Secret
+		key := client.ObjectKey{Namespace: aiGateway.Namespace, Name: ref.Name}
+		if err := v.APIReader.Get(ctx, key, &secret); err != nil {
+			requestLog(ctx, aigatewaylog, aiGateway).Info("Skipping API key check", "model", model.Name, "error", err)
+			continue
+		}
+		apiKey := string(secret.Data[ref.Key])
+		if apiKey == "" {
+			continue
+		}
+		lister, _ := v.newModelLister(model.Provider, apiKey)
+		if _, err := lister.ListModels(ctx); providers.IsUnauthorized(err) {
+			warnings = append(warnings, fmt.Sprintf("AI model %q: provider %s rejected the API key in key %q of "+
+				"Secret %q (%v); requests to the model will fail", model.Name, model.Provider, ref.Key, ref.Name, err))
+		} else if err != nil {
+			requestLog(ctx, aigatewaylog, aiGateway).Info("Failed to check API key", "model", model.Name, "error", err)
+		}
+	}
+	return warnings, nil
+}
+
+// newModelLister returns the model lister of the public API of a provider.
+func (v *AiGatewayCustomValidator) newModelLister(provider, apiKey string) (providers.ModelLister, bool) {
+	if v.modelLister != nil {
+		return v.modelLister(provider, apiKey)
+	}
+	return providers.NewModelLister(provider, "", apiKey)
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// requestLog returns the logger for the admission of obj with its generation. controller-runtime passes a
+// logger carrying the requestID and the key of the resource in the context of every admission request; log,
+// the logger of the resource kind, is used with the key of obj if there is none, e.g. in unit tests.
+func requestLog(ctx context.Context, log logr.Logger, obj client.Object) logr.Logger {
+	if reqLog, err := logr.FromContext(ctx); err == nil {
+		return reqLog.WithValues("generation", obj.GetGeneration())
+	}
+	return log.WithValues("namespace", obj.GetNamespace(), "name", obj.GetName(),
+		"generation", obj.GetGeneration())
+}
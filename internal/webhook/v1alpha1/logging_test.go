@@ -0,0 +1,58 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Logging", func() {
+	var (
+		lines []string
+		log   logr.Logger
+		obj   *gatewayv1alpha1.AiGateway
+	)
+
+	BeforeEach(func() {
+		lines = nil
+		log = funcr.New(func(prefix, args string) { lines = append(lines, args) }, funcr.Options{})
+		obj = &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "team-a", Generation: 2},
+		}
+	})
+
+	It("Should add the generation to the logger of the admission request", func() {
+		reqCtx := logr.NewContext(context.Background(), log.WithValues("requestID", "4711"))
+
+		requestLog(reqCtx, logr.Discard(), obj).Info("validating")
+		Expect(lines).To(ConsistOf(`"level"=0 "msg"="validating" "requestID"="4711" "generation"=2`))
+	})
+
+	It("Should add the key of the resource without a logger of the admission request", func() {
+		requestLog(context.Background(), log, obj).Info("validating")
+		Expect(lines).To(ConsistOf(
+			`"level"=0 "msg"="validating" "namespace"="team-a" "name"="gateway" "generation"=2`))
+	})
+})
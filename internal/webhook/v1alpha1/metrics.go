@@ -0,0 +1,67 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// webhookDenials counts the admission requests rejected by the validating webhooks. Objects created with
+// generateName are labeled by their generateName, as their name is only assigned once they are admitted.
+var webhookDenials = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "aigateway_webhook_denials_total",
+	Help: "Number of requests denied by the validating webhooks, per kind, namespace, name and reason.",
+}, []string{"kind", "namespace", "name", "reason"})
+
+func init() {
+	// Metrics registered with the controller-runtime registry are served from the manager's metrics endpoint.
+	metrics.Registry.MustRegister(webhookDenials)
+}
+
+// recordValidation counts the denial of obj if err is set. Dry-run requests are not counted, as they don't change
+// the cluster, e.g. kubectl diff.
+func recordValidation(ctx context.Context, kind string, obj client.Object, err error) {
+	if err == nil {
+		return
+	}
+	if req, reqErr := admission.RequestFromContext(ctx); reqErr == nil && req.DryRun != nil && *req.DryRun {
+		return
+	}
+	name := obj.GetName()
+	if name == "" {
+		name = obj.GetGenerateName()
+	}
+	webhookDenials.WithLabelValues(kind, obj.GetNamespace(), name, denialReason(err)).Inc()
+}
+
+// denialReason returns the reason the webhook server reports for err: the reason of API errors, like Invalid, and
+// Forbidden for all other errors.
+func denialReason(err error) string {
+	var apiStatus apierrors.APIStatus
+	if errors.As(err, &apiStatus) && apiStatus.Status().Reason != metav1.StatusReasonUnknown {
+		return string(apiStatus.Status().Reason)
+	}
+	return string(metav1.StatusReasonForbidden)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+// secretProviderClassGVK is the kind of the SecretProviderClasses of the Secrets Store CSI driver. It is read as
+// unstructured, so the driver is not a dependency of this operator.
+var secretProviderClassGVK = schema.GroupVersionKind{
+	Group: "secrets-store.csi.x-k8s.io", Version: "v1", Kind: "SecretProviderClass",
+}
+
+// validateSecretProviderClassRef validates the mount path and the synced Secret of spec.secretProviderClassRef.
+func validateSecretProviderClassRef(fldPath *field.Path, ref *gatewayv1alpha1.SecretProviderClassRef) field.ErrorList {
+	var allErrs field.ErrorList
+	if ref.MountPath != "" && (!path.IsAbs(ref.MountPath) || path.Clean(ref.MountPath) == "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mountPath"), ref.MountPath,
+			"must be an absolute path other than /"))
+	}
+	if ref.SyncedSecretName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(ref.SyncedSecretName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("syncedSecretName"), ref.SyncedSecretName, msg))
+		}
+	}
+	return allErrs
+}
+
+// secretsStoreWarnings warns if the SecretProviderClass referenced by spec.secretProviderClassRef does not exist,
+// e.g. because the Secrets Store CSI driver is not installed, or does not sync the SyncedSecretName. The gateway
+// pods cannot start in both cases, but the AiGateway is admitted to allow creating the SecretProviderClass after it.
+func (v *AiGatewayCustomValidator) secretsStoreWarnings(ctx context.Context,
+	aiGateway *gatewayv1alpha1.AiGateway) (admission.Warnings, error) {
+	ref := aiGateway.Spec.SecretProviderClassRef
+	if ref == nil || ref.Name == "" {
+		return nil, nil
+	}
+
+	secretProviderClass := &unstructured.Unstructured{}
+	secretProviderClass.SetGroupVersionKind(secretProviderClassGVK)
+	key := client.ObjectKey{Namespace: aiGateway.Namespace, Name: ref.Name}
+	switch err := v.Client.Get(ctx, key, secretProviderClass); {
+	case meta.IsNoMatchError(err):
+		return admission.Warnings{fmt.Sprintf("SecretProviderClass %q cannot be mounted because the Secrets Store "+
+			"CSI driver is not installed; the gateway pods will not start until it is", ref.Name)}, nil
+	case apierrors.IsNotFound(err):
+		return admission.Warnings{fmt.Sprintf("SecretProviderClass %q does not exist in namespace %q; the gateway "+
+			"pods will not start until it is created", ref.Name, aiGateway.Namespace)}, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to get SecretProviderClass %s: %w", key, err)
+	}
+
+	if ref.SyncedSecretName == "" {
+		return nil, nil
+	}
+	secretObjects, _, _ := unstructured.NestedSlice(secretProviderClass.Object, "spec", "secretObjects")
+	if !slices.ContainsFunc(secretObjects, func(obj any) bool {
+		secretObject, ok := obj.(map[string]any)
+		return ok && secretObject["secretName"] == ref.SyncedSecretName
+	}) {
+		return admission.Warnings{fmt.Sprintf("SecretProviderClass %q does not sync the Secret %q via "+
+			"spec.secretObjects; the gateway pods will not start", ref.Name, ref.SyncedSecretName)}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// tracer creates the spans of admission requests and the API calls made to handle them. It uses the global
+// TracerProvider, which does not record anything unless the manager is started with --enable-tracing.
+var tracer = otel.Tracer("github.com/agentic-layer/ai-gateway-operator/internal/webhook/v1alpha1")
+
+// startSpan starts the span of an admission operation on obj and adds its traceID to the logger of the request.
+func startSpan(ctx context.Context, kind, operation string, obj runtime.Object) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{attribute.String("k8s.resource.kind", kind)}
+	if o, ok := obj.(client.Object); ok {
+		attributes = append(attributes,
+			attribute.String("k8s.namespace.name", o.GetNamespace()),
+			attribute.String("k8s.resource.name", o.GetName()),
+			attribute.Int64("k8s.resource.generation", o.GetGeneration()))
+	}
+	ctx, span := tracer.Start(ctx, kind+" "+operation, trace.WithAttributes(attributes...))
+	if reqLog, err := logr.FromContext(ctx); err == nil && span.SpanContext().IsValid() {
+		ctx = logr.NewContext(ctx, reqLog.WithValues("traceID", span.SpanContext().TraceID().String()))
+	}
+	return ctx, span
+}
+
+// endSpan records err on the span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// tracingValidator records a span for every validation of a resource of the given kind.
+type tracingValidator struct {
+	kind      string
+	validator webhook.CustomValidator
+}
+
+var _ webhook.CustomValidator = &tracingValidator{}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (v *tracingValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ctx, span := startSpan(ctx, v.kind, "validate create", obj)
+	warnings, err := v.validator.ValidateCreate(ctx, obj)
+	endSpan(span, err)
+	return warnings, err
+}
+
+// ValidateUpdate implements webhook.CustomValidator.
+func (v *tracingValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	ctx, span := startSpan(ctx, v.kind, "validate update", newObj)
+	warnings, err := v.validator.ValidateUpdate(ctx, oldObj, newObj)
+	endSpan(span, err)
+	return warnings, err
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (v *tracingValidator) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ctx, span := startSpan(ctx, v.kind, "validate delete", obj)
+	warnings, err := v.validator.ValidateDelete(ctx, obj)
+	endSpan(span, err)
+	return warnings, err
+}
+
+// tracingDefaulter records a span for every defaulting of a resource of the given kind.
+type tracingDefaulter struct {
+	kind      string
+	defaulter webhook.CustomDefaulter
+}
+
+var _ webhook.CustomDefaulter = &tracingDefaulter{}
+
+// Default implements webhook.CustomDefaulter.
+func (d *tracingDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	ctx, span := startSpan(ctx, d.kind, "default", obj)
+	err := d.defaulter.Default(ctx, obj)
+	endSpan(span, err)
+	return err
+}
+
+// tracingClient records a span for every read the webhooks make, as child of the span of the admission request.
+type tracingClient struct {
+	client.Client
+}
+
+// Get implements client.Reader.
+func (c tracingClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return tracingReader{Reader: c.Client, scheme: c.Scheme()}.Get(ctx, key, obj, opts...)
+}
+
+// List implements client.Reader.
+func (c tracingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return tracingReader{Reader: c.Client, scheme: c.Scheme()}.List(ctx, list, opts...)
+}
+
+// tracingReader records a span for every read of a reader, like tracingClient, e.g. of the uncached API reader.
+type tracingReader struct {
+	client.Reader
+	scheme *runtime.Scheme
+}
+
+// Get implements client.Reader.
+func (r tracingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	ctx, span := tracer.Start(ctx, "get "+r.kind(obj), trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("k8s.namespace.name", key.Namespace),
+			attribute.String("k8s.resource.name", key.Name)))
+	err := r.Reader.Get(ctx, key, obj, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// List implements client.Reader.
+func (r tracingReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	ctx, span := tracer.Start(ctx, "list "+r.kind(list), trace.WithSpanKind(trace.SpanKindClient))
+	err := r.Reader.List(ctx, list, opts...)
+	endSpan(span, err)
+	return err
+}
+
+// kind returns the kind of obj for span names.
+func (r tracingReader) kind(obj runtime.Object) string {
+	gvk, err := apiutil.GVKForObject(obj, r.scheme)
+	if err != nil {
+		return "unknown"
+	}
+	return gvk.Kind
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2025 Agentic Layer.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayv1alpha1 "github.com/agentic-layer/ai-gateway-operator/api/v1alpha1"
+)
+
+var _ = Describe("Tracing", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	It("Should record a span per admission request with the API calls as children", func() {
+		validator := &tracingValidator{kind: "AiGateway",
+			validator: &AiGatewayCustomValidator{Client: tracingClient{k8sClient}}}
+		obj := &gatewayv1alpha1.AiGateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "traced", Namespace: "default", Generation: 3},
+			Spec: gatewayv1alpha1.AiGatewaySpec{
+				AiModels:       []gatewayv1alpha1.AiModel{{Name: "gpt-4o", Provider: "openai"}},
+				ModelLibraries: []gatewayv1alpha1.ModelLibraryImport{{Name: "missing-library"}},
+			},
+		}
+
+		_, err := validator.ValidateCreate(ctx, obj)
+		Expect(err).To(HaveOccurred())
+
+		spans := recorder.Ended()
+		Expect(spans).NotTo(BeEmpty())
+		admission := spans[len(spans)-1]
+		Expect(admission.Name()).To(Equal("AiGateway validate create"))
+		Expect(admission.Attributes()).To(ContainElements(
+			attribute.String("k8s.namespace.name", "default"),
+			attribute.String("k8s.resource.name", "traced"),
+			attribute.Int64("k8s.resource.generation", 3)))
+		Expect(admission.Status().Code).To(Equal(codes.Error))
+
+		var calls []string
+		for _, span := range spans[:len(spans)-1] {
+			Expect(span.Parent().SpanID()).To(Equal(admission.SpanContext().SpanID()))
+			calls = append(calls, span.Name())
+		}
+		Expect(calls).To(ContainElement("get AiModelLibrary"))
+	})
+})
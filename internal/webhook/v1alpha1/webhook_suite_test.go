@@ -109,10 +109,28 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).NotTo(HaveOccurred())
 
-	err = SetupAiGatewayWebhookWithManager(mgr)
+	err = SetupAiGatewayWebhookWithManager(mgr, false, "default")
 	Expect(err).NotTo(HaveOccurred())
 
-	err = SetupAiGatewayClassWebhookWithManager(mgr)
+	err = SetupAiGatewayClassWebhookWithManager(mgr, false, "default")
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupAiRateLimitPolicyWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupAiGatewayRouteWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupClusterAiGatewayWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupAiTeamWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupAiVirtualKeyWebhookWithManager(mgr)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = SetupAiPromptTemplateWebhookWithManager(mgr)
 	Expect(err).NotTo(HaveOccurred())
 
 	// +kubebuilder:scaffold:webhook